@@ -8,13 +8,20 @@ package assert
 
 import (
 	"bytes"
+	"cmp"
 	"errors"
+	"fmt"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 )
 
 // TestingT is the subset of [testing.T] (see also [testing.TB]) used by the assert package.
+//
+// Other methods of [testing.TB] are optional; see capability.go for how
+// their absence is handled.
 type TestingT interface {
 	Error(args ...any)
 	Errorf(format string, args ...any)
@@ -22,137 +29,392 @@ type TestingT interface {
 	Fatalf(format string, args ...any)
 }
 
-type helperT interface {
-	Helper()
-}
-
 type equaler[T any] interface {
 	Equal(T) bool
 }
 
-func True(t TestingT, got bool, msg ...string) {
+func True(t TestingT, got bool, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
 	if !got {
-		t.Fatalf("got: false; want: true;%s", formatMsg(msg...))
+		t.Fatalf("got: false; want: true;%s", cfg.msg())
 	}
 }
 
-func False(t TestingT, got bool, msg ...string) {
+func False(t TestingT, got bool, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
 	if got {
-		t.Fatalf("got: true; want: false;%s", formatMsg(msg...))
+		t.Fatalf("got: true; want: false;%s", cfg.msg())
 	}
 }
 
-func Equal[T any](t TestingT, got, want T, msg ...string) {
+func Equal[T any](t TestingT, got, want T, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
-	if !isEqual(got, want) {
-		t.Fatalf("got: %#v; want: %#v;%s", got, want, formatMsg(msg...))
+	if failure := equalMismatch(got, want, cfg); failure != "" {
+		t.Fatalf("%s", failure)
 	}
 }
 
-func NotEqual[T any](t TestingT, got, want T, msg ...string) {
+// equalMismatch compares got and want using the rules of [Equal], and returns
+// a failure message, or "" if they are equal.
+func equalMismatch[T any](got, want T, cfg *config) string {
+	if isEqual(got, want, cfg) {
+		return ""
+	}
+	if g, w, ok := multilineStrings(got, want); ok {
+		return textMismatch(g, w, cfg)
+	}
+	if out, ok := externalValueDiff(got, want); ok {
+		return fmt.Sprintf("got and want differ;%s\n%s", cfg.msg(), out)
+	}
+	if g, ok := any(got).(map[string]any); ok {
+		if lines := treeDiff(g, any(want), cfg); len(lines) > 0 {
+			return fmt.Sprintf("got and want differ;%s\n  %s", cfg.msg(), strings.Join(limitLines(lines), "\n  "))
+		}
+	}
+	if stats, ok := numericSliceStats(got, want, cfg); ok {
+		return fmt.Sprintf("got: %s; want: %s; %s;%s", cfg.format(got), cfg.format(want), stats, cfg.msg())
+	}
+	return fmt.Sprintf("got: %s; want: %s;%s", cfg.format(got), cfg.format(want), cfg.msg())
+}
+
+// multilineStrings returns got and want as strings, and reports whether they
+// are strings of which at least one spans multiple lines.
+func multilineStrings(got, want any) (g, w string, ok bool) {
+	gv, wv := reflect.ValueOf(got), reflect.ValueOf(want)
+	if gv.Kind() != reflect.String || wv.Kind() != reflect.String {
+		return "", "", false
+	}
+	g, w = gv.String(), wv.String()
+	return g, w, strings.Contains(g, "\n") || strings.Contains(w, "\n")
+}
+
+func NotEqual[T any](t TestingT, got, want T, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
-	if isEqual(got, want) {
-		t.Fatalf("got: %#v; expected values to be different;%s", got, formatMsg(msg...))
+	if isEqual(got, want, cfg) {
+		t.Fatalf("got: %s; expected values to be different;%s", cfg.format(got), cfg.msg())
 	}
 }
 
-func Nil(t TestingT, got any, msg ...string) {
+// EqualFunc asserts that eq reports got and want as equal, for one-off
+// comparisons that Equal and its options don't cover.
+func EqualFunc[T any](t TestingT, got, want T, eq func(a, b T) bool, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
+
+	if !eq(got, want) {
+		t.Fatalf("got: %s; want: %s;%s", cfg.format(got), cfg.format(want), cfg.msg())
+	}
+}
+
+// Same asserts that got and want point to the same address.
+func Same[T any](t TestingT, got, want *T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if got != want {
+		t.Fatalf("got: %s; want: %s; expected same pointer;%s",
+			formatPointer(got), formatPointer(want), cfg.msg())
+	}
+}
+
+// NotSame asserts that got and want point to different addresses.
+func NotSame[T any](t TestingT, got, want *T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if got == want {
+		t.Fatalf("got: %s; expected different pointers;%s", formatPointer(got), cfg.msg())
+	}
+}
+
+func Nil(t TestingT, got any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
 
 	if !isNil(got) {
-		t.Fatalf("got: %#v; want: <nil>;%s", got, formatMsg(msg...))
+		t.Fatalf("got: %s; want: <nil>;%s", cfg.format(got), cfg.msg())
 	}
 }
 
-func NotNil(t TestingT, got any, msg ...string) {
+func NotNil(t TestingT, got any, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
 	if isNil(got) {
-		t.Fatalf("got: <nil>; expected non-nil;%s", formatMsg(msg...))
+		t.Fatalf("got: %s; expected non-nil;%s", cfg.format(got), cfg.msg())
+	}
+}
+
+// Len asserts that got, which must be a string, slice, array, map or
+// channel, has length want.
+func Len(t TestingT, got any, want int, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	rv := reflect.ValueOf(got)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		if rv.Len() != want {
+			t.Fatalf("got: len %d (%s); want: len %d;%s", rv.Len(), cfg.format(got), want, cfg.msg())
+		}
+	default:
+		t.Fatalf("got: %T; want a type with a length;%s", got, cfg.msg())
+	}
+}
+
+// NilNotEmptySlice asserts that s is nil rather than merely empty, for
+// contracts that tell the two apart, such as JSON null versus [].
+func NilNotEmptySlice[T any](t TestingT, s []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if s != nil {
+		t.Fatalf("got: %s; want: nil slice;%s", cfg.format(s), cfg.msg())
+	}
+}
+
+// EmptyNotNilSlice asserts that s is empty but not nil, for contracts that
+// tell the two apart, such as JSON [] versus null.
+func EmptyNotNilSlice[T any](t TestingT, s []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if s == nil || len(s) > 0 {
+		t.Fatalf("got: %s; want: empty non-nil slice;%s", cfg.format(s), cfg.msg())
+	}
+}
+
+func Error(t TestingT, got error, want any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if failure := errorMismatch(got, want); failure != "" {
+		t.Fatalf("%s%s%s", failure, cfg.msg(), errorDetail(got))
 	}
 }
 
-func Error(t TestingT, got error, want any, msg ...string) {
+func MatchesRegex(t TestingT, got, pattern string, opts ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
+	cfg := newConfig(t, opts...)
 
+	if matched, err := regexp.MatchString(pattern, got); err != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
+	} else if !matched {
+		t.Fatalf("got: %q; want to match %q;%s", got, pattern, cfg.msg())
+	}
+}
+
+// MatchRegex asserts that got matches the precompiled re, and returns the
+// leftmost match and its submatches as by [regexp.Regexp.FindStringSubmatch],
+// for further assertions on the captured values. It returns nil on failure.
+func MatchRegex(t TestingT, got string, re *regexp.Regexp, opts ...any) []string {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	m := re.FindStringSubmatch(got)
+	if m == nil {
+		t.Fatalf("got: %q; want to match %q;%s", got, re, cfg.msg())
+	}
+	return m
+}
+
+// NotMatchesRegex asserts that got does not match pattern, reporting the first
+// match found.
+func NotMatchesRegex(t TestingT, got, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
+		return
+	}
+	if loc := re.FindStringIndex(got); loc != nil {
+		t.Fatalf("got: %q; want not to match %q; matched %q at %v;%s",
+			got, pattern, got[loc[0]:loc[1]], loc, cfg.msg())
+	}
+}
+
+// errorMismatch matches got against want using the rules of [Error], and
+// returns a failure message, or "" if got matches.
+func errorMismatch(got error, want any) string {
 	switch w := want.(type) {
 	case nil:
+		if _, ok := joinedErrors(got); ok {
+			return fmt.Sprintf("unexpected error: %s;", formatError(got))
+		}
 		if got != nil {
-			t.Fatalf("unexpected error: %s;%s", got, formatMsg(msg...))
+			return fmt.Sprintf("unexpected error: %s;", got)
 		}
 	case string:
+		if got == nil {
+			return fmt.Sprintf("got: <nil>; want: %q;", w)
+		}
 		if !strings.Contains(got.Error(), w) {
-			t.Fatalf("got: %q; want: %q;%s", got, want, formatMsg(msg...))
+			return fmt.Sprintf("got: %q; want: %q;", got, want)
 		}
 	case error:
 		if !errors.Is(got, w) {
 			if isNil(got) {
-				t.Fatalf("got: <nil>; want: %T(%v);%s", w, w, formatMsg(msg...))
-			} else {
-				t.Fatalf("got: %T(%v); want: %T(%v);%s", got, got, w, w, formatMsg(msg...))
+				return fmt.Sprintf("got: <nil>; want: %T(%v);", w, w)
 			}
+			return fmt.Sprintf("got: %s; want: %s;", formatError(got), formatError(w))
 		}
 	case reflect.Type:
 		target := reflect.New(w).Interface()
 		if !errors.As(got, target) {
-			t.Fatalf("got: %T; want: %v;%s", got, w, formatMsg(msg...))
+			return fmt.Sprintf("got: %T; want: %v;", got, w)
+		}
+	case *regexp.Regexp:
+		if got == nil {
+			return fmt.Sprintf("got: <nil>; want: error matching %q;", w)
+		}
+		if !w.MatchString(got.Error()) {
+			return fmt.Sprintf("got: %q; want to match %q;", got, w)
+		}
+	case func(error) bool:
+		if w == nil {
+			return fmt.Sprintf("unsupported want: nil %T;", w)
+		}
+		if !w(got) {
+			return fmt.Sprintf("got: %s; does not satisfy predicate;", formatError(got))
 		}
 	default:
-		t.Fatalf("unsupported want type: %T", want)
+		return fmt.Sprintf("unsupported want type: %T; want one of: %s;%s",
+			want, errorWantKinds, errorWantHint(want))
 	}
+	return ""
 }
 
-func MatchesRegex(t TestingT, got, pattern string, msg ...string) {
-	if ht, ok := t.(helperT); ok {
-		ht.Helper()
-	}
+// errorWantKinds lists the kinds of want supported by errorMismatch.
+const errorWantKinds = "nil, string, error, reflect.Type, *regexp.Regexp, func(error) bool"
 
-	if matched, err := regexp.MatchString(pattern, got); err != nil {
-		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
-	} else if !matched {
-		t.Fatalf("got: %q; want to match %q;%s", got, pattern, formatMsg(msg...))
+// errorWantHint suggests what was likely intended by an unsupported want
+// passed to errorMismatch, or returns "".
+func errorWantHint(want any) string {
+	wt := reflect.TypeOf(want)
+	switch {
+	case wt == nil:
+		return ""
+	case wt.Kind() == reflect.Func:
+		return " hint: predicates must have type func(error) bool;"
+	case wt == reflect.TypeFor[[]error]():
+		return " hint: use ErrorsEqual to compare lists of errors;"
+	case reflect.PointerTo(wt).Implements(reflect.TypeFor[error]()):
+		return fmt.Sprintf(" hint: %v implements error with a pointer receiver, pass a pointer;", wt)
+	case wt.Implements(reflect.TypeFor[fmt.Stringer]()):
+		return " hint: pass a string to match the error message;"
 	}
+	return ""
 }
 
-func isEqual[T any](got, want T) bool {
+func isEqual[T any](got, want T, cfg *config) bool {
 	if isNil(got) && isNil(want) {
 		return true
 	}
 
+	// EquateApproxTime takes precedence over time.Time's own Equal method.
+	if _, ok := any(got).(time.Time); ok && cfg.approxTime {
+		return deepEqual(got, want, cfg)
+	}
+
 	if equalable, ok := any(got).(equaler[T]); ok {
 		return equalable.Equal(want)
 	}
 
+	// Equal methods are found reflectively when T is an interface type, and
+	// types such as *big.Int provide Cmp instead of Equal.
+	if equal, ok := methodEqual(got, want); ok {
+		return equal
+	}
+
 	// Special case for byte slices.
 	if aBytes, ok := any(got).([]byte); ok {
 		bBytes := any(want).([]byte)
 		return bytes.Equal(aBytes, bBytes)
 	}
 
-	// Fallback to reflective comparison.
+	// Fallback to reflective comparison, honoring any comparison options.
+	if cfg.deep() {
+		return deepEqual(got, want, cfg)
+	}
 	return reflect.DeepEqual(got, want)
 }
 
+// methodEqual compares values whose type has an Equal method returning a
+// bool, or a Cmp method returning an int, that takes the same type. It reports
+// whether such a method was found.
+func methodEqual(got, want any) (equal, ok bool) {
+	gv, wv := reflect.ValueOf(got), reflect.ValueOf(want)
+	if !gv.IsValid() || !wv.IsValid() || gv.Type() != wv.Type() {
+		return false, false
+	}
+
+	var kind reflect.Kind
+	m := gv.MethodByName("Equal")
+	if kind = reflect.Bool; !m.IsValid() {
+		m = gv.MethodByName("Cmp")
+		kind = reflect.Int
+	}
+	if !m.IsValid() {
+		return false, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.NumOut() != 1 || mt.In(0) != gv.Type() || mt.Out(0).Kind() != kind {
+		return false, false
+	}
+
+	// Such methods rarely accept nil receivers or arguments.
+	if isNil(got) || isNil(want) {
+		return false, true
+	}
+	out := m.Call([]reflect.Value{wv})[0]
+	if kind == reflect.Bool {
+		return out.Bool(), true
+	}
+	return out.Int() == 0, true
+}
+
 func isNil(v any) bool {
 	if v == nil {
 		return true
@@ -166,14 +428,54 @@ func isNil(v any) bool {
 	return false
 }
 
-func formatMsg(msg ...string) string {
-	if len(msg) == 0 {
-		return ""
+// sortedKeys returns the keys of m in a stable order for failure output.
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b K) int {
+		return compareValues(reflect.ValueOf(a), reflect.ValueOf(b))
+	})
+	return keys
+}
+
+// compareValues orders numbers and strings naturally, and anything else by
+// its rendered form.
+func compareValues(a, b reflect.Value) int {
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(a.Uint(), b.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(a.Float(), b.Float())
+		case reflect.String:
+			return cmp.Compare(a.String(), b.String())
+		}
+	}
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+// formatPointer renders a pointer as its address and pointee.
+func formatPointer[T any](p *T) string {
+	if p == nil {
+		return fmt.Sprintf("(%T)(nil)", p)
 	}
+	return fmt.Sprintf("%p (%#v)", p, *p)
+}
 
-	if len(msg[0]) == 0 {
+func formatMsg(msg ...string) string {
+	var parts []string
+	for _, m := range msg {
+		if m != "" {
+			parts = append(parts, m)
+		}
+	}
+	if len(parts) == 0 {
 		return ""
 	}
 
-	return " " + strings.Join(msg, "; ")
+	return " " + strings.Join(parts, "; ")
 }
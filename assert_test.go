@@ -8,11 +8,15 @@
 package assert
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"io/fs"
+	"math/big"
 	"math/rand/v2"
 	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -92,6 +96,10 @@ func TestFormatMsg(t *testing.T) {
 			msg:      []string{"one"},
 			expected: " one",
 		},
+		"leading empty string": {
+			msg:      []string{"", "two"},
+			expected: " two",
+		},
 		"has two strings": {
 			msg:      []string{"one", "two"},
 			expected: " one; two",
@@ -194,6 +202,16 @@ func TestEqual(t *testing.T) {
 				got: []int{42, 84}, want: []int{84, 42},
 				msg: `got: []int{42, 84}; want: []int{84, 42};`,
 			},
+			"multi-line string": {
+				got: "a\nb\nc", want: "a\nB\nc",
+				msg: "got and want differ;\n--- got\n+++ want\n  a\n- b\n+ B\n  c",
+			},
+			"long float slice": {
+				got:  []float64{0, 1, 2.5, 3, 4, 5, 6, 7, 8, 9, 10, 12},
+				want: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+				msg: "got: []float64{0, 1, 2.5, 3, 4, 5, 6, 7, 8, 9, 10, 12}; want: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}; " +
+					"2 of 12 elements differ; first at [2]; last at [11]; max |diff|: 1 at [11]; mean diff: 0.75;",
+			},
 			"int slice vs any slice": {
 				got: []int{42, 84}, want: []any{42, 84},
 				msg: `got: []int{42, 84}; want: []interface {}{42, 84};`,
@@ -220,6 +238,24 @@ func TestEqual(t *testing.T) {
 			"chan": {
 				got: make(chan int), want: make(chan int),
 			},
+			"nested Equal method": {
+				got:  []noisy{{val: 1}, {val: 2}},
+				want: []noisy{{val: 1}, {val: 3}},
+				msg:  "got: []assert.noisy{assert.noisy{val:1, noise:0}, assert.noisy{val:2, noise:0}}; want: []assert.noisy{assert.noisy{val:1, noise:0}, assert.noisy{val:3, noise:0}};",
+			},
+			"nested time.Time": {
+				got: []time.Time{now}, want: []time.Time{now.Add(time.Second)},
+			},
+			// Like reflect.DeepEqual, nested values are compared by their
+			// fields rather than their Equal methods.
+			"nested Equal method ignored": {
+				got:  map[string][]noisy{"a": {{val: 1, noise: 0.1}}},
+				want: map[string][]noisy{"a": {{val: 1, noise: 0.2}}},
+			},
+			"nested time.Time location": {
+				got:  struct{ at time.Time }{now},
+				want: struct{ at time.Time }{now.In(time.UTC)},
+			},
 		}
 
 		for name, tc := range testCases {
@@ -417,6 +453,18 @@ func TestErrors(t *testing.T) {
 				t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
 			}
 		})
+
+		t.Run("got nil", func(t *testing.T) {
+			tb := &mockTB{}
+			Error(tb, nil, "day")
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			wantMsg := `got: <nil>; want: "day";`
+			if tb.msg != wantMsg {
+				t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+			}
+		})
 	})
 
 	t.Run("want type", func(t *testing.T) {
@@ -456,7 +504,90 @@ func TestErrors(t *testing.T) {
 		if !tb.fatal {
 			t.Error("should be fatal")
 		}
-		wantMsg := "unsupported want type: int"
+		wantMsg := "unsupported want type: int; want one of: nil, string, error, reflect.Type, *regexp.Regexp, func(error) bool;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("unsupported want hints", func(t *testing.T) {
+		const kinds = "want one of: nil, string, error, reflect.Type, *regexp.Regexp, func(error) bool;"
+		testCases := map[string]struct {
+			want any
+			msg  string
+		}{
+			"predicate signature": {
+				want: func(err error) string { return "" },
+				msg:  "unsupported want type: func(error) string; " + kinds + " hint: predicates must have type func(error) bool;",
+			},
+			"error list": {
+				want: []error{errors.New("oops")},
+				msg:  "unsupported want type: []error; " + kinds + " hint: use ErrorsEqual to compare lists of errors;",
+			},
+			"pointer receiver": {
+				want: fs.PathError{},
+				msg:  "unsupported want type: fs.PathError; " + kinds + " hint: fs.PathError implements error with a pointer receiver, pass a pointer;",
+			},
+			"stringer": {
+				want: time.Second,
+				msg:  "unsupported want type: time.Duration; " + kinds + " hint: pass a string to match the error message;",
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				tb := &mockTB{}
+				Error(tb, errors.New("oops"), tc.want)
+				if tb.msg != tc.msg {
+					t.Errorf("got: %q; want: %q", tb.msg, tc.msg)
+				}
+			})
+		}
+	})
+
+	t.Run("want regexp", func(t *testing.T) {
+		testCases := map[string]struct {
+			got error
+			msg string
+		}{
+			"matches":  {got: errors.New("code 404: not found")},
+			"no match": {got: errors.New("timeout"), msg: `got: "timeout"; want to match "^code \\d+";`},
+			"nil":      {got: nil, msg: `got: <nil>; want: error matching "^code \\d+";`},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				tb := &mockTB{}
+				Error(tb, tc.got, regexp.MustCompile(`^code \d+`))
+				if tb.msg != tc.msg {
+					t.Errorf("got: %q; want: %q", tb.msg, tc.msg)
+				}
+			})
+		}
+	})
+
+	t.Run("want predicate", func(t *testing.T) {
+		isNotExist := func(err error) bool { return errors.Is(err, fs.ErrNotExist) }
+
+		tb := &mockTB{}
+		Error(tb, fmt.Errorf("open: %w", fs.ErrNotExist), isNotExist)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+
+		tb = &mockTB{}
+		Error(tb, errType("oops"), isNotExist, "lookup")
+		wantMsg := "got: assert.errType(oops); does not satisfy predicate; lookup"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+		}
+
+		tb = &mockTB{}
+		Error(tb, errType("oops"), (func(error) bool)(nil))
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg = "unsupported want: nil func(error) bool;"
 		if tb.msg != wantMsg {
 			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
 		}
@@ -497,3 +628,370 @@ func TestMatchesRegex(t *testing.T) {
 		}
 	})
 }
+
+func TestMatchRegex(t *testing.T) {
+	re := regexp.MustCompile(`user=(\w+) id=(\d+)`)
+
+	t.Run("match", func(t *testing.T) {
+		tb := &mockTB{}
+		m := MatchRegex(tb, "ts=1 user=bob id=42", re)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if want := []string{"user=bob id=42", "bob", "42"}; !slices.Equal(m, want) {
+			t.Errorf("got: %q; want: %q;", m, want)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		tb := &mockTB{}
+		m := MatchRegex(tb, "user=bob", re, "log line")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: "user=bob"; want to match "user=(\\w+) id=(\\d+)"; log line`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+		if m != nil {
+			t.Errorf("got: %q; want: nil;", m)
+		}
+	})
+}
+
+func TestNotMatchesRegex(t *testing.T) {
+	testCases := map[string]struct {
+		got, pattern string
+		msg          string
+	}{
+		"no match": {got: "user=bob", pattern: `token=\w+`},
+		"match": {
+			got: "user=bob token=abc123", pattern: `token=\w+`,
+			msg: `got: "user=bob token=abc123"; want not to match "token=\\w+"; matched "token=abc123" at [9 21];`,
+		},
+		"bad regex": {
+			got: "some test", pattern: `test[nothing`,
+			msg: "unable to parse regexp pattern test[nothing: error parsing regexp: missing closing ]: `[nothing`",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			NotMatchesRegex(tb, tc.got, tc.pattern)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"case insensitive": {check: func(tb TestingT) { EqualFunc(tb, "Go", "GO", strings.EqualFold) }},
+		"custom key": {check: func(tb TestingT) {
+			EqualFunc(tb, intType{42}, intType{42}, func(a, b intType) bool { return a.val == b.val })
+		}},
+		"not equal": {
+			check: func(tb TestingT) { EqualFunc(tb, "Go", "Rust", strings.EqualFold, "names") },
+			msg:   `got: "Go"; want: "Rust"; names`,
+		},
+		"float format": {
+			check: func(tb TestingT) {
+				EqualFunc(tb, 1.0, 2.0, func(a, b float64) bool { return a == b }, FloatFormat('f', 2))
+			},
+			msg: "got: 1.00; want: 2.00;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestSame(t *testing.T) {
+	t.Run("same", func(t *testing.T) {
+		val := 42
+		p1, p2 := &val, &val
+		tb := &mockTB{}
+		Same(tb, p1, p2)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+
+		tb2 := &mockTB{}
+		NotSame(tb2, p1, p2)
+		if !tb2.failed {
+			t.Error("should have failed")
+		}
+		if !tb2.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := fmt.Sprintf("got: %p (42); expected different pointers;", p1)
+		if tb2.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb2.msg, wantMsg)
+		}
+	})
+
+	t.Run("different", func(t *testing.T) {
+		val1, val2 := 42, 42
+		p1, p2 := &val1, &val2
+		tb := &mockTB{}
+		Same(tb, p1, p2)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := fmt.Sprintf("got: %p (42); want: %p (42); expected same pointer;", p1, p2)
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+		}
+
+		tb2 := &mockTB{}
+		NotSame(tb2, p1, p2)
+		if tb2.failed {
+			t.Errorf("failed: %s", tb2.msg)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		val := 42
+		tb := &mockTB{}
+		Same(tb, &val, nil)
+		wantMsg := fmt.Sprintf("got: %p (42); want: (*int)(nil); expected same pointer;", &val)
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestNil(t *testing.T) {
+	val := 42
+	ptr := &val
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"nil":         {check: func(tb TestingT) { Nil(tb, nil) }},
+		"nil pointer": {check: func(tb TestingT) { Nil(tb, (*int)(nil)) }},
+		"nil map":     {check: func(tb TestingT) { Nil(tb, map[string]int(nil)) }},
+		"non-nil":     {check: func(tb TestingT) { NotNil(tb, 42) }},
+		"pointer": {
+			check: func(tb TestingT) { Nil(tb, ptr) },
+			msg:   fmt.Sprintf("got: (*int)(%p) -> 42; want: <nil>;", ptr),
+		},
+		"struct pointer": {
+			check: func(tb TestingT) { Nil(tb, &intType{42}) },
+			msg:   "got: &assert.intType{val:42}; want: <nil>;",
+		},
+		"untyped nil": {
+			check: func(tb TestingT) { NotNil(tb, nil) },
+			msg:   "got: <nil>; expected non-nil;",
+		},
+		"typed nil pointer": {
+			check: func(tb TestingT) { NotNil(tb, (*intType)(nil)) },
+			msg:   "got: (*assert.intType)(nil); expected non-nil;",
+		},
+		"typed nil map": {
+			check: func(tb TestingT) { NotNil(tb, map[string]int(nil)) },
+			msg:   "got: map[string]int(nil); expected non-nil;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestLen(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"slice":  {check: func(tb TestingT) { Len(tb, []int{1, 2}, 2) }},
+		"string": {check: func(tb TestingT) { Len(tb, "abc", 3) }},
+		"map":    {check: func(tb TestingT) { Len(tb, map[string]int{"a": 1}, 1) }},
+		"nil":    {check: func(tb TestingT) { Len(tb, []int(nil), 0) }},
+		"wrong length": {
+			check: func(tb TestingT) { Len(tb, []int{1, 2}, 3) },
+			msg:   "got: len 2 ([]int{1, 2}); want: len 3;",
+		},
+		"no length": {
+			check: func(tb TestingT) { Len(tb, 42, 3) },
+			msg:   "got: int; want a type with a length;",
+		},
+		"nil slice": {check: func(tb TestingT) { NilNotEmptySlice(tb, []string(nil)) }},
+		"empty not nil slice": {
+			check: func(tb TestingT) { NilNotEmptySlice(tb, []string{}, "tags") },
+			msg:   "got: []string{}; want: nil slice; tags",
+		},
+		"empty slice": {check: func(tb TestingT) { EmptyNotNilSlice(tb, []int{}) }},
+		"nil not empty slice": {
+			check: func(tb TestingT) { EmptyNotNilSlice(tb, []int(nil)) },
+			msg:   "got: []int(nil); want: empty non-nil slice;",
+		},
+		"non-empty slice": {
+			check: func(tb TestingT) { EmptyNotNilSlice(tb, []int{1}) },
+			msg:   "got: []int{1}; want: empty non-nil slice;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+// money is an ordered type comparing via Cmp.
+type money struct {
+	cents int64
+	tag   string
+}
+
+func (m money) Cmp(other money) int {
+	return cmp.Compare(m.cents, other.cents)
+}
+
+func TestEqualBig(t *testing.T) {
+	testCases := map[string]struct {
+		got, want any
+		msg       string
+	}{
+		"int": {
+			got:  big.NewInt(42),
+			want: new(big.Int).Add(big.NewInt(40), big.NewInt(2)),
+		},
+		"float with different precision": {
+			got:  big.NewFloat(1.5),
+			want: new(big.Float).SetPrec(200).SetFloat64(1.5),
+		},
+		"rat": {
+			got:  big.NewRat(1, 3),
+			want: big.NewRat(2, 6),
+		},
+		"different int": {
+			got:  big.NewInt(42),
+			want: big.NewInt(84),
+			msg:  "got: *big.Int(42); want: *big.Int(84);",
+		},
+		"different float": {
+			got:  big.NewFloat(1.5),
+			want: big.NewFloat(2.25),
+			msg:  "got: *big.Float(1.5); want: *big.Float(2.25);",
+		},
+		"different rat": {
+			got:  big.NewRat(1, 3),
+			want: big.NewRat(1, 2),
+			msg:  "got: *big.Rat(1/3); want: *big.Rat(1/2);",
+		},
+		"nil vs value": {
+			got:  (*big.Int)(nil),
+			want: big.NewInt(1),
+			msg:  "got: (*big.Int)(nil); want: *big.Int(1);",
+		},
+		"Cmp method": {
+			got:  money{cents: 100, tag: "a"},
+			want: money{cents: 100, tag: "b"},
+		},
+		"different Cmp": {
+			got:  money{cents: 100},
+			want: money{cents: 200},
+			msg:  `got: assert.money{cents:100, tag:""}; want: assert.money{cents:200, tag:""};`,
+		},
+		"different types": {
+			got:  big.NewInt(1),
+			want: big.NewRat(1, 1),
+			msg:  "got: *big.Int(1); want: *big.Rat(1);",
+		},
+		// Cmp methods are only used at the top level; nested values are
+		// compared as by reflect.DeepEqual.
+		"nested Cmp method": {
+			got:  map[string]money{"total": {cents: 100, tag: "a"}},
+			want: map[string]money{"total": {cents: 100, tag: "b"}},
+			msg:  `got: map[string]assert.money{"total":assert.money{cents:100, tag:"a"}}; want: map[string]assert.money{"total":assert.money{cents:100, tag:"b"}};`,
+		},
+		"different nested Cmp": {
+			got:  []money{{cents: 100}},
+			want: []money{{cents: 200}},
+			msg:  `got: []assert.money{assert.money{cents:100, tag:""}}; want: []assert.money{assert.money{cents:200, tag:""}};`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			Equal(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CompletesWithin runs fn in a new goroutine and asserts that it returns
+// within d. If fn panics, the panic is re-raised in the calling goroutine
+// with the same value. If fn calls runtime.Goexit, as t.FailNow does, it did
+// not complete and the assertion fails. When d elapses first, fn is left
+// running.
+func CompletesWithin(t TestingT, d time.Duration, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	type result struct {
+		panicked bool
+		returned bool
+		value    any
+	}
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		// Deferred so that done is also signaled if fn calls
+		// runtime.Goexit, which a deferred recover doesn't stop: catchPanic
+		// then never returns, and r.returned is left unset.
+		defer func() { done <- r }()
+		r.panicked, r.value = catchPanic(fn)
+		r.returned = true
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		if r.panicked {
+			panic(r.value)
+		}
+		if !r.returned {
+			t.Fatalf("got: runtime.Goexit; want: completed;%s", cfg.msg())
+		}
+	case <-timer.C:
+		t.Fatalf("got: still running after %s; want: completed;%s", d, cfg.msg())
+	}
+}
+
+// Eventually asserts that cond returns true within timeout, calling it
+// immediately and then every interval.
+func Eventually(t TestingT, cond func() bool, timeout, interval time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if interval <= 0 {
+		t.Fatalf("invalid interval %s; want a positive interval;%s", interval, cfg.msg())
+		return
+	}
+	run := poll(cfg.clock(), cond, timeout, every(interval))
+	recordPolling(0, run.attempts, run.elapsed, timeout, !run.stopped)
+	if !run.stopped {
+		t.Fatalf("got: condition still false; want: true within %s;%s%s",
+			timeout, cfg.msg(), pollDetails(cfg, "timeout", timeout, run.elapsed, run.attempts))
+	}
+}
+
+// Never asserts that cond returns false on every call during window, calling
+// it immediately and then every interval.
+func Never(t TestingT, cond func() bool, window, interval time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if interval <= 0 {
+		t.Fatalf("invalid interval %s; want a positive interval;%s", interval, cfg.msg())
+		return
+	}
+	if run := poll(cfg.clock(), cond, window, every(interval)); run.stopped {
+		t.Fatalf("got: condition true after %s; want: false throughout %s;%s%s",
+			run.elapsed.Round(time.Microsecond), window, cfg.msg(), pollDetails(cfg, "window", window, run.elapsed, run.attempts))
+	}
+}
+
+// Consistently asserts that cond returns true on every call during window,
+// calling it immediately and then every interval. It fails at the first
+// violation.
+func Consistently(t TestingT, cond func() bool, window, interval time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if interval <= 0 {
+		t.Fatalf("invalid interval %s; want a positive interval;%s", interval, cfg.msg())
+		return
+	}
+	run := poll(cfg.clock(), func() bool { return !cond() }, window, every(interval))
+	if run.stopped {
+		t.Fatalf("got: condition false at %s, after %s; want: true throughout %s;%s%s",
+			run.at.Format(time.RFC3339Nano), run.elapsed.Round(time.Microsecond), window, cfg.msg(),
+			pollDetails(cfg, "window", window, run.elapsed, run.attempts))
+	}
+}
+
+// pollRun describes a run of poll.
+type pollRun struct {
+	stopped  bool      // stop returned true before the budget ran out
+	attempts int       // calls to stop
+	at       time.Time // time of the last call to stop
+	elapsed  time.Duration
+}
+
+// minPollInterval is the shortest interval poll waits between attempts, so
+// that it neither spins nor stalls a [FakeClock] on a non-positive interval.
+const minPollInterval = time.Millisecond
+
+// poll calls stop immediately and then after each interval, until it returns
+// true or budget has elapsed. Once budget has elapsed, stop is called one last
+// time. interval is given the number of attempts made so far, and is raised
+// to minPollInterval if shorter.
+func poll(clock Clock, stop func() bool, budget time.Duration, interval func(attempts int) time.Duration) pollRun {
+	start := clock.Now()
+	deadline := start.Add(budget)
+	var run pollRun
+	for {
+		run.attempts++
+		run.at = clock.Now()
+		run.stopped = stop()
+		run.elapsed = clock.Now().Sub(start)
+		if run.stopped {
+			return run
+		}
+		remaining := deadline.Sub(clock.Now())
+		if remaining <= 0 {
+			return run
+		}
+		clock.Sleep(min(max(interval(run.attempts), minPollInterval), remaining))
+	}
+}
+
+// every returns a poll interval function for a constant interval.
+func every(d time.Duration) func(int) time.Duration {
+	return func(int) time.Duration { return d }
+}
+
+// pollDetails renders the indented block that follows the failure line of a
+// polling assertion, ending with the state reported by the Describe option if
+// one was given. label names the polling budget, such as "timeout".
+func pollDetails(cfg *config, label string, budget, elapsed time.Duration, attempts int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  %-9s %s", label+":", budget)
+	fmt.Fprintf(&b, "\n  %-9s %s", "elapsed:", elapsed.Round(time.Microsecond))
+	fmt.Fprintf(&b, "\n  %-9s %d", "attempts:", attempts)
+	if cfg.describe != nil {
+		fmt.Fprintf(&b, "\n  %-9s %s", "last:", cfg.describe())
+	}
+	return b.String()
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCompletesWithin(t *testing.T) {
+	t.Run("completes", func(t *testing.T) {
+		tb := &mockTB{}
+		CompletesWithin(tb, time.Second, func() {})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("goexit", func(t *testing.T) {
+		tb := &mockTB{}
+		CompletesWithin(tb, time.Second, runtime.Goexit, "worker")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got: runtime.Goexit; want: completed; worker"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("hangs", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		tb := &mockTB{}
+		CompletesWithin(tb, 10*time.Millisecond, func() { <-release }, "stuck")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got: still running after 10ms; want: completed; stuck"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		tb := &mockTB{}
+		PanicsWithValue(t, "oops", func() {
+			CompletesWithin(tb, time.Second, func() { panic("oops") })
+		})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
+
+func TestEventually(t *testing.T) {
+	t.Run("immediately", func(t *testing.T) {
+		tb := &mockTB{}
+		Eventually(tb, func() bool { return true }, 0, time.Hour)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("after polling", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		Eventually(tb, func() bool {
+			calls++
+			return calls == 3
+		}, time.Second, time.Millisecond)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if calls != 3 {
+			t.Errorf("got: %d calls; want: 3 calls;", calls)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		tb := &mockTB{}
+		Eventually(tb, func() bool { return false }, 10*time.Millisecond, time.Hour, "never ready")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		// One call right away, and one more once the timeout has elapsed.
+		wantMsg := regexp.MustCompile(`^got: condition still false; want: true within 10ms; never ready
+  timeout:  10ms
+  elapsed:  \S+
+  attempts: 2$`)
+		if !wantMsg.MatchString(tb.msg) {
+			t.Errorf("got: %q; want match: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		depth := 0
+		tb := &mockTB{}
+		Eventually(tb, func() bool {
+			depth++
+			return false
+		}, 10*time.Millisecond, time.Hour, Describe(func() string {
+			return fmt.Sprintf("queue depth %d", depth)
+		}))
+		wantMsg := regexp.MustCompile(`^got: condition still false; want: true within 10ms;
+  timeout:  10ms
+  elapsed:  \S+
+  attempts: 2
+  last:     queue depth 2$`)
+		if !wantMsg.MatchString(tb.msg) {
+			t.Errorf("got: %q; want match: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestNever(t *testing.T) {
+	t.Run("never true", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		Never(tb, func() bool {
+			calls++
+			return false
+		}, 10*time.Millisecond, time.Hour)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if calls != 2 {
+			t.Errorf("got: %d calls; want: 2 calls;", calls)
+		}
+	})
+
+	t.Run("becomes true", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		Never(tb, func() bool {
+			calls++
+			return calls == 3
+		}, time.Second, time.Millisecond, Describe(func() string {
+			return fmt.Sprintf("%d deliveries", calls)
+		}), "duplicate delivery")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := regexp.MustCompile(`^got: condition true after \S+; want: false throughout 1s; duplicate delivery
+  window:   1s
+  elapsed:  \S+
+  attempts: 3
+  last:     3 deliveries$`)
+		if !wantMsg.MatchString(tb.msg) {
+			t.Errorf("got: %q; want match: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestConsistently(t *testing.T) {
+	t.Run("always true", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		Consistently(tb, func() bool {
+			calls++
+			return true
+		}, 10*time.Millisecond, time.Hour)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if calls != 2 {
+			t.Errorf("got: %d calls; want: 2 calls;", calls)
+		}
+	})
+
+	t.Run("violated", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		Consistently(tb, func() bool {
+			calls++
+			return calls < 2
+		}, time.Second, time.Millisecond, "steady state")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		if calls != 2 {
+			t.Errorf("got: %d calls; want: 2 calls;", calls)
+		}
+		wantMsg := regexp.MustCompile(`^got: condition false at \d{4}-\S+, after \S+; want: true throughout 1s; steady state
+  window:   1s
+  elapsed:  \S+
+  attempts: 2$`)
+		if !wantMsg.MatchString(tb.msg) {
+			t.Errorf("got: %q; want match: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestPollingInterval(t *testing.T) {
+	clk := NewFakeClock(time.Unix(0, 0))
+	cond := func() bool { return true }
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"Eventually": {
+			check: func(tb TestingT) { Eventually(tb, cond, time.Second, 0, WithClock(clk)) },
+			msg:   "invalid interval 0s; want a positive interval;",
+		},
+		"Never": {
+			check: func(tb TestingT) { Never(tb, cond, time.Second, -time.Second, WithClock(clk)) },
+			msg:   "invalid interval -1s; want a positive interval;",
+		},
+		"Consistently": {
+			check: func(tb TestingT) { Consistently(tb, cond, time.Second, 0, WithClock(clk), "poll") },
+			msg:   "invalid interval 0s; want a positive interval; poll",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
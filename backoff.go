@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// defaultBackoffInterval is the first interval of a [Backoff] that doesn't
+// set one.
+const defaultBackoffInterval = 10 * time.Millisecond
+
+// Backoff configures the polling of [EventuallyWith]. The interval before
+// attempt n+1 is Initial*Multiplier^(n-1) + Step*(n-1), capped at Max and
+// then varied by Jitter. Leaving Step and Multiplier unset polls at a
+// constant interval; setting Step gives linear backoff, and a Multiplier
+// above 1 gives exponential backoff.
+type Backoff struct {
+	// Timeout is the total time to poll for.
+	Timeout time.Duration
+	// Initial is the first interval. It defaults to 10ms.
+	Initial time.Duration
+	// Step is added to the interval after every attempt.
+	Step time.Duration
+	// Multiplier scales the interval after every attempt, if above 1.
+	Multiplier float64
+	// Max caps the interval, if positive.
+	Max time.Duration
+	// Jitter randomly varies each interval by up to this fraction of it, in
+	// either direction. It is clamped to [0, 1].
+	Jitter float64
+}
+
+// interval returns the interval to wait after the given number of attempts.
+func (b Backoff) interval(attempts int) time.Duration {
+	initial := b.Initial
+	if initial <= 0 {
+		initial = defaultBackoffInterval
+	}
+	n := float64(max(attempts-1, 0))
+
+	d := float64(initial)
+	if b.Multiplier > 1 {
+		d *= math.Pow(b.Multiplier, n)
+	}
+	d += float64(b.Step) * n
+	if b.Max > 0 {
+		d = min(d, float64(b.Max))
+	}
+	if jitter := min(max(b.Jitter, 0), 1); jitter > 0 {
+		d += d * jitter * (2*rand.Float64() - 1)
+	}
+	// float64(math.MaxInt64) rounds up to 1<<63, which doesn't convert.
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// EventuallyWith is like [Eventually], but polls according to b.
+func EventuallyWith(t TestingT, cond func() bool, b Backoff, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	run := poll(cfg.clock(), cond, b.Timeout, b.interval)
+	recordPolling(0, run.attempts, run.elapsed, b.Timeout, !run.stopped)
+	if !run.stopped {
+		t.Fatalf("got: condition still false; want: true within %s;%s%s",
+			b.Timeout, cfg.msg(), pollDetails(cfg, "timeout", b.Timeout, run.elapsed, run.attempts))
+	}
+}
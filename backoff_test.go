@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestBackoffInterval(t *testing.T) {
+	testCases := map[string]struct {
+		backoff Backoff
+		want    []time.Duration
+	}{
+		"default":     {backoff: Backoff{}, want: []time.Duration{10 * time.Millisecond, 10 * time.Millisecond}},
+		"constant":    {backoff: Backoff{Initial: time.Second}, want: []time.Duration{time.Second, time.Second, time.Second}},
+		"linear":      {backoff: Backoff{Initial: time.Second, Step: time.Second}, want: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}},
+		"exponential": {backoff: Backoff{Initial: time.Second, Multiplier: 2}, want: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}},
+		"capped": {
+			backoff: Backoff{Initial: time.Second, Multiplier: 3, Max: 5 * time.Second},
+			want:    []time.Duration{time.Second, 3 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		"overflow": {
+			backoff: Backoff{Initial: time.Hour, Multiplier: 1e6},
+			want:    []time.Duration{time.Hour, 1e6 * time.Hour, time.Duration(1<<63 - 1)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.want {
+				if got := tc.backoff.interval(i + 1); got != want {
+					t.Errorf("attempt %d: got: %s; want: %s;", i+1, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("jitter", func(t *testing.T) {
+		b := Backoff{Initial: time.Second, Jitter: 0.5}
+		for range 100 {
+			if got := b.interval(1); got < 500*time.Millisecond || got > 1500*time.Millisecond {
+				t.Fatalf("got: %s; want: between 500ms and 1.5s;", got)
+			}
+		}
+	})
+}
+
+func TestEventuallyWith(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		calls := 0
+		tb := &mockTB{}
+		EventuallyWith(tb, func() bool {
+			calls++
+			return calls == 4
+		}, Backoff{Timeout: time.Second, Initial: time.Millisecond, Multiplier: 2})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		tb := &mockTB{}
+		EventuallyWith(tb, func() bool { return false }, Backoff{Timeout: 10 * time.Millisecond, Initial: time.Hour}, "backoff")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := regexp.MustCompile(`^got: condition still false; want: true within 10ms; backoff
+  timeout:  10ms
+  elapsed:  \S+
+  attempts: 2$`)
+		if !wantMsg.MatchString(tb.msg) {
+			t.Errorf("got: %q; want match: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("shrinking interval", func(t *testing.T) {
+		clk := NewFakeClock(time.Unix(0, 0))
+		calls := 0
+		tb := &mockTB{}
+		EventuallyWith(tb, func() bool {
+			calls++
+			return false
+		}, Backoff{Timeout: 10 * time.Millisecond, Initial: time.Nanosecond, Step: -time.Millisecond}, WithClock(clk))
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		// Raised to minPollInterval: 10 waits, and one more call at the end.
+		if calls != 11 {
+			t.Errorf("got: %d calls; want: 11 calls;", calls)
+		}
+	})
+}
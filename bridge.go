@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"github.com/dropwhile/assert/internal/bridge"
+)
+
+func init() {
+	bridge.NewConfig = func(t any, opts []any) any {
+		tb := t.(TestingT)
+		if ht, ok := tb.(helperT); ok {
+			ht.Helper()
+		}
+		// Skip this function and core.NewConfig, to tally the assertion
+		// that called core.NewConfig.
+		countUsage(2)
+		c := parseConfig(opts...)
+		c.reportUnsupported(tb)
+		return c
+	}
+	bridge.Format = func(cfg, v any) string {
+		return cfg.(*config).format(v)
+	}
+	bridge.Msg = func(cfg any) string {
+		return cfg.(*config).msg()
+	}
+	bridge.Equal = func(cfg, got, want any) bool {
+		return isEqual(got, want, cfg.(*config))
+	}
+}
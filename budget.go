@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxHeapGrowth asserts that the live heap grows by at most limit bytes while
+// fn runs. Garbage is collected before and after fn, so only memory still
+// reachable afterwards counts. The measurement is process-wide and coarse:
+// it is meant to catch gross regressions, not to account for every byte.
+func MaxHeapGrowth(t TestingT, limit uint64, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	before := stableHeapAlloc()
+	fn()
+	after := stableHeapAlloc()
+
+	if after > before && after-before > limit {
+		t.Fatalf("got: heap grew by %d bytes; want: <= %d bytes;%s", after-before, limit, cfg.msg())
+	}
+}
+
+// stableHeapAlloc returns the bytes of live heap objects after collecting
+// garbage. Two collections are run so that objects freed by finalizers are
+// also reclaimed.
+func stableHeapAlloc() uint64 {
+	runtime.GC()
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.HeapAlloc
+}
+
+// MaxCPUTime asserts that fn consumes at most limit of user plus system CPU
+// time. Unlike wall clock time, CPU time can't be hidden by parallelism or
+// sleeps. It is measured for the whole process, so concurrently running
+// tests are included. On platforms without CPU time accounting, MaxCPUTime
+// skips the test, or fails it if t can't skip.
+func MaxCPUTime(t TestingT, limit time.Duration, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	before, ok := processCPUTime()
+	if !ok {
+		skipOrFail(t, fmt.Sprintf("CPU time is not supported on %s;%s", runtime.GOOS, cfg.msg()))
+		return
+	}
+	fn()
+	after, _ := processCPUTime()
+
+	if used := after - before; used > limit {
+		t.Fatalf("got: %s of CPU time; want: <= %s;%s", used, limit, cfg.msg())
+	}
+}
+
+// Throughput asserts that fn can be run at least minOpsPerSec times per
+// second. fn is first run for a tenth of sample to warm up, then in growing
+// batches until sample has elapsed. Time is measured as process CPU time
+// where supported, so the rate doesn't depend on how busy the machine is,
+// and as wall clock time otherwise.
+//
+// The assertion fails if sample is not reached within throughputWallFactor
+// times sample of wall clock time, as when fn blocks rather than uses the
+// CPU.
+func Throughput(t TestingT, fn func(), minOpsPerSec float64, sample time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	now, kind := processCPUTime, "CPU time"
+	if _, ok := processCPUTime(); !ok {
+		start := time.Now()
+		now, kind = func() (time.Duration, bool) { return time.Since(start), true }, "wall time"
+	}
+
+	wallStart := time.Now()
+	deadline := wallStart.Add(throughputWallFactor * sample)
+	ops, elapsed, ok := measureOps(fn, sample/10, now, deadline)
+	if ok {
+		ops, elapsed, ok = measureOps(fn, sample, now, deadline)
+	}
+	if !ok {
+		t.Fatalf("got: %s of %s after %s of wall time; want: %s of %s; fn seems to block rather than use the CPU;%s",
+			elapsed, kind, time.Since(wallStart).Round(time.Millisecond), sample, kind, cfg.msg())
+		return
+	}
+
+	if rate := float64(ops) / elapsed.Seconds(); !(rate >= minOpsPerSec) {
+		t.Fatalf("got: %.1f ops/s (%d ops in %s of %s); want: >= %v ops/s;%s",
+			rate, ops, elapsed, kind, minOpsPerSec, cfg.msg())
+	}
+}
+
+// throughputWallFactor bounds the wall clock time [Throughput] may take, as a
+// multiple of its sample duration.
+const throughputWallFactor = 5
+
+// measureOps runs fn in doubling batches until at least d has elapsed as
+// measured by now, and returns the number of runs and the time they took.
+// fn is always run at least once. ok is false if the wall clock passed
+// deadline first; it is checked between batches.
+func measureOps(fn func(), d time.Duration, now func() (time.Duration, bool), deadline time.Time) (ops int, elapsed time.Duration, ok bool) {
+	start, _ := now()
+	for batch := 1; ; batch = min(batch*2, 1<<20) {
+		for range batch {
+			fn()
+		}
+		ops += batch
+		end, _ := now()
+		if elapsed = end - start; elapsed >= d {
+			// Guard against a zero duration from a coarse clock.
+			return ops, max(elapsed, time.Nanosecond), true
+		}
+		if time.Now().After(deadline) {
+			return ops, elapsed, false
+		}
+	}
+}
+
+// EncodedSizeAtMost asserts that the output of marshal for v, such as
+// [encoding/json.Marshal], is at most limit bytes. If the output is a JSON
+// object, the failure breaks its size down by top-level field, largest first.
+func EncodedSizeAtMost(t TestingT, v any, limit int, marshal func(any) ([]byte, error), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	data, err := marshal(v)
+	if err != nil {
+		t.Fatalf("unable to marshal %T: %s;%s", v, err, cfg.msg())
+		return
+	}
+	if len(data) > limit {
+		t.Fatalf("got: %d bytes; want: <= %d bytes;%s%s", len(data), limit, fieldSizes(data), cfg.msg())
+	}
+}
+
+// fieldSizes renders the encoded size of each top-level field of data, if it
+// is a JSON object, or returns "".
+func fieldSizes(data []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || len(fields) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		if c := cmp.Compare(len(fields[b]), len(fields[a])); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	sizes := make([]string, len(names))
+	for i, name := range names {
+		sizes[i] = fmt.Sprintf("%q %d", name, len(fields[name]))
+	}
+	return fmt.Sprintf(" fields: %s;", strings.Join(limitLines(sizes), ", "))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var heapSink [][]byte
+
+func TestMaxHeapGrowth(t *testing.T) {
+	t.Run("within limit", func(t *testing.T) {
+		tb := &mockTB{}
+		MaxHeapGrowth(tb, 1<<20, func() {
+			// Garbage that is collected before the heap is measured.
+			for range 100 {
+				_ = make([]byte, 1<<16)
+			}
+		})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("exceeds limit", func(t *testing.T) {
+		defer func() { heapSink = nil }()
+
+		tb := &mockTB{}
+		MaxHeapGrowth(tb, 1<<20, func() {
+			heapSink = append(heapSink, make([]byte, 8<<20))
+		}, "retained")
+		if !tb.fatal {
+			t.Fatal("should be fatal")
+		}
+		if !strings.HasPrefix(tb.msg, "got: heap grew by ") || !strings.HasSuffix(tb.msg, "; want: <= 1048576 bytes; retained") {
+			t.Errorf("unexpected message: %q", tb.msg)
+		}
+	})
+}
+
+func TestMaxCPUTime(t *testing.T) {
+	if _, ok := processCPUTime(); !ok {
+		rec := &RecordingT{}
+		MaxCPUTime(rec, time.Second, func() {})
+		if f := rec.Failures(); len(f) != 1 || !strings.HasPrefix(f[0].Message, "CPU time is not supported on ") {
+			t.Errorf("unexpected failures: %v", f)
+		}
+		return
+	}
+
+	t.Run("sleeping is free", func(t *testing.T) {
+		tb := &mockTB{}
+		MaxCPUTime(tb, 25*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("exceeds limit", func(t *testing.T) {
+		tb := &mockTB{}
+		MaxCPUTime(tb, time.Millisecond, func() {
+			start, _ := processCPUTime()
+			for {
+				if now, _ := processCPUTime(); now-start > 10*time.Millisecond {
+					return
+				}
+			}
+		}, "busy")
+		if !tb.fatal {
+			t.Fatal("should be fatal")
+		}
+		if !strings.HasPrefix(tb.msg, "got: ") || !strings.HasSuffix(tb.msg, " of CPU time; want: <= 1ms; busy") {
+			t.Errorf("unexpected message: %q", tb.msg)
+		}
+	})
+}
+
+func TestThroughput(t *testing.T) {
+	t.Run("fast enough", func(t *testing.T) {
+		tb := &mockTB{}
+		sum := 0
+		Throughput(tb, func() { sum++ }, 1000, 20*time.Millisecond)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("too slow", func(t *testing.T) {
+		tb := &mockTB{}
+		Throughput(tb, func() {
+			start := time.Now()
+			for time.Since(start) < time.Millisecond {
+			}
+		}, 1e6, 20*time.Millisecond, "hot path")
+		if !tb.fatal {
+			t.Fatal("should be fatal")
+		}
+		if !strings.HasPrefix(tb.msg, "got: ") || !strings.HasSuffix(tb.msg, "; want: >= 1e+06 ops/s; hot path") {
+			t.Errorf("unexpected message: %q", tb.msg)
+		}
+	})
+
+	t.Run("blocking", func(t *testing.T) {
+		if _, ok := processCPUTime(); !ok {
+			t.Skip("no CPU time on this platform")
+		}
+		tb := &mockTB{}
+		start := time.Now()
+		Throughput(tb, func() { time.Sleep(time.Millisecond) }, 1, 20*time.Millisecond)
+		if took := time.Since(start); took > time.Second {
+			t.Errorf("got: took %s; want: bounded by the wall clock;", took)
+		}
+		if !tb.fatal {
+			t.Fatal("should be fatal")
+		}
+		if !strings.HasSuffix(tb.msg, "; want: 20ms of CPU time; fn seems to block rather than use the CPU;") {
+			t.Errorf("unexpected message: %q", tb.msg)
+		}
+	})
+}
+
+func TestMeasureOps(t *testing.T) {
+	var clock time.Duration
+	now := func() (time.Duration, bool) { return clock, true }
+	ops, elapsed, ok := measureOps(func() { clock += time.Millisecond }, 10*time.Millisecond, now, time.Now().Add(time.Hour))
+	// Batches of 1, 2, 4 and 8 runs take 15ms in total.
+	if ops != 15 || elapsed != 15*time.Millisecond || !ok {
+		t.Errorf("got: %d ops in %s (ok %v); want: 15 ops in 15ms;", ops, elapsed, ok)
+	}
+
+	clock = 0
+	ops, _, ok = measureOps(func() {}, 10*time.Millisecond, now, time.Now())
+	if ops != 1 || ok {
+		t.Errorf("got: %d ops (ok %v); want: 1 op past the deadline;", ops, ok)
+	}
+}
+
+func TestEncodedSizeAtMost(t *testing.T) {
+	type payload struct {
+		ID    int      `json:"id"`
+		Items []string `json:"items"`
+		Note  string   `json:"note"`
+	}
+	v := payload{ID: 1, Items: []string{"apple", "pear"}, Note: "hi"}
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"within":   {check: func(tb TestingT) { EncodedSizeAtMost(tb, v, 100, json.Marshal) }},
+		"at limit": {check: func(tb TestingT) { EncodedSizeAtMost(tb, v, 45, json.Marshal) }},
+		"over": {
+			check: func(tb TestingT) { EncodedSizeAtMost(tb, v, 40, json.Marshal, "wire") },
+			msg:   `got: 45 bytes; want: <= 40 bytes; fields: "items" 16, "note" 4, "id" 1; wire`,
+		},
+		"not an object": {
+			check: func(tb TestingT) { EncodedSizeAtMost(tb, []int{1, 2, 3}, 4, json.Marshal) },
+			msg:   "got: 7 bytes; want: <= 4 bytes;",
+		},
+		"marshal error": {
+			check: func(tb TestingT) { EncodedSizeAtMost(tb, make(chan int), 4, json.Marshal) },
+			msg:   "unable to marshal chan int: json: unsupported type: chan int;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"reflect"
+)
+
+// A [TestingT] only has to report failures. The optional methods of
+// [testing.TB] below are detected with interface assertions, and every
+// assertion works without them:
+//
+//   - Helper is called so that failures are reported at the caller's line.
+//     Without it, locations point into this package.
+//   - FailNow is how [testing.T] makes Fatal stop the test. Without it,
+//     Fatal and Fatalf may return: every assertion returns right after
+//     reporting a fatal failure, and [Subject] chains skip the assertions
+//     that follow one.
+//   - Cleanup registers end-of-test checks. Assertions that cannot work
+//     without one, such as [Closes], report a failure naming the missing
+//     method instead.
+//   - Name identifies the test in records written for tooling. Without it,
+//     the name is left empty.
+//   - Skip is used to skip rather than fail when an assertion can't run in
+//     the current environment. Without it, such assertions fail instead.
+//   - Run runs subtests, as in [GoldenCorpus]. It is detected reflectively,
+//     so that it may take a func(*testing.T) or a callback of any other
+//     TestingT type. Without it, the subtests run inline.
+
+type helperT interface {
+	Helper()
+}
+
+type failNowT interface {
+	FailNow()
+}
+
+type cleanupT interface {
+	Cleanup(func())
+}
+
+type nameT interface {
+	Name() string
+}
+
+type skipT interface {
+	Skip(args ...any)
+}
+
+// testName returns the name of the test run by t, or "" if t has no Name
+// method.
+func testName(t TestingT) string {
+	if nt, ok := t.(nameT); ok {
+		return nt.Name()
+	}
+	return ""
+}
+
+// runSubtest runs fn as a subtest of t named name, and reports whether t can
+// run subtests: whether it has a method Run(string, func(T)) bool, for some
+// type T that implements TestingT.
+func runSubtest(t TestingT, name string, fn func(t TestingT)) bool {
+	m := reflect.ValueOf(t).MethodByName("Run")
+	if !m.IsValid() {
+		return false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 2 || mt.In(0).Kind() != reflect.String || mt.In(1).Kind() != reflect.Func ||
+		mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		return false
+	}
+	ft := mt.In(1)
+	if ft.NumIn() != 1 || ft.NumOut() != 0 || !ft.In(0).Implements(reflect.TypeFor[TestingT]()) {
+		return false
+	}
+
+	callback := reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		fn(args[0].Interface().(TestingT))
+		return nil
+	})
+	m.Call([]reflect.Value{reflect.ValueOf(name).Convert(mt.In(0)), callback})
+	return true
+}
+
+// skipOrFail skips the test with msg if t can skip, and otherwise fails it.
+func skipOrFail(t TestingT, msg string) {
+	if st, ok := t.(skipT); ok {
+		st.Skip(msg)
+		return
+	}
+	t.Fatal(msg)
+}
+
+// fatalTracker wraps a TestingT that lacks FailNow, to tell whether a fatal
+// failure was reported through it.
+type fatalTracker struct {
+	TestingT
+	fatal bool
+}
+
+func (f *fatalTracker) Helper() {
+	if ht, ok := f.TestingT.(helperT); ok {
+		ht.Helper()
+	}
+}
+
+func (f *fatalTracker) Fatal(args ...any) {
+	f.fatal = true
+	f.TestingT.Fatal(args...)
+}
+
+func (f *fatalTracker) Fatalf(format string, args ...any) {
+	f.fatal = true
+	f.TestingT.Fatalf(format, args...)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"testing"
+)
+
+// skipTB is a mockTB that supports Skip.
+type skipTB struct {
+	mockTB
+	skipped string
+}
+
+func (s *skipTB) Skip(args ...any) {
+	s.skipped = fmt.Sprint(args...)
+}
+
+func TestSkipOrFail(t *testing.T) {
+	t.Run("can skip", func(t *testing.T) {
+		tb := &skipTB{}
+		skipOrFail(tb, "unsupported")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if tb.skipped != "unsupported" {
+			t.Errorf("got: %q; want: %q;", tb.skipped, "unsupported")
+		}
+	})
+
+	t.Run("cannot skip", func(t *testing.T) {
+		rec := &RecordingT{}
+		skipOrFail(rec, "unsupported")
+		if !FailedFatally(rec) {
+			t.Error("should be fatal")
+		}
+	})
+}
+
+func TestFatalTracker(t *testing.T) {
+	rec := &RecordingT{}
+	ft := &fatalTracker{TestingT: rec}
+	Equal(ft, 1, 1)
+	if ft.fatal {
+		t.Error("should not be fatal")
+	}
+	Equal(ft, 1, 2)
+	if !ft.fatal {
+		t.Error("should be fatal")
+	}
+	if !FailedFatally(rec) {
+		t.Error("should be forwarded")
+	}
+}
+
+// suiteT is a custom TestingT whose Run method takes a callback of its own
+// type, as in test suite frameworks.
+type suiteT struct {
+	*RecordingT
+	ran []string
+}
+
+func (s *suiteT) Run(name string, fn func(t *suiteT)) bool {
+	s.ran = append(s.ran, name)
+	fn(s)
+	return true
+}
+
+// badRunT has a Run method with an unrelated signature.
+type badRunT struct {
+	*RecordingT
+}
+
+func (badRunT) Run(name string) {}
+
+func TestRunSubtest(t *testing.T) {
+	t.Run("testing.T", func(t *testing.T) {
+		var got string
+		if !runSubtest(t, "sub", func(t TestingT) { got = testName(t) }) {
+			t.Fatal("got: not run; want: run as subtest;")
+		}
+		if want := "TestRunSubtest/testing.T/sub"; got != want {
+			t.Errorf("got: %q; want: %q;", got, want)
+		}
+	})
+
+	t.Run("custom callback type", func(t *testing.T) {
+		s := &suiteT{RecordingT: &RecordingT{}}
+		called := false
+		if !runSubtest(s, "case", func(tb TestingT) { called = tb == TestingT(s) }) {
+			t.Fatal("got: not run; want: run as subtest;")
+		}
+		if !called || len(s.ran) != 1 || s.ran[0] != "case" {
+			t.Errorf("got: called %v, ran %q; want: called with s, ran [case];", called, s.ran)
+		}
+	})
+
+	t.Run("no Run method", func(t *testing.T) {
+		for _, tb := range []TestingT{&RecordingT{}, badRunT{&RecordingT{}}} {
+			if runSubtest(tb, "sub", func(TestingT) { t.Error("should not run") }) {
+				t.Errorf("got: ran with %T; want: not run;", tb)
+			}
+		}
+	})
+}
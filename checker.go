@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Checker has the method set of the Checker interface of
+// github.com/frankban/quicktest. Go interfaces are satisfied structurally,
+// so quicktest checkers can be used as Checkers, with [Subject.Passes], and
+// Checkers made with [AsChecker] can be used with quicktest, without either
+// package importing the other.
+//
+// ArgNames returns the names of the checked value and of the arguments, as
+// in {"got", "want"}. Check returns an error if got doesn't pass, and may
+// call note to attach extra details to the failure.
+type Checker interface {
+	Check(got any, args []any, note func(key string, value any)) error
+	ArgNames() []string
+}
+
+// AsChecker returns a [Checker] that passes if check reports no failure to
+// the TestingT it is given, so that assertions of this package can be used
+// as quicktest checkers:
+//
+//	isPrefix := assert.AsChecker([]string{"got", "prefix"}, func(t assert.TestingT, got any, args []any) {
+//		assert.HasPrefix(t, got.(string), args[0].(string))
+//	})
+//	qt.Assert(t, "hello", isPrefix, "he")
+//
+// argNames is returned by ArgNames, and must name got as well as the
+// arguments.
+func AsChecker(argNames []string, check func(t TestingT, got any, args []any)) Checker {
+	return &funcChecker{argNames: argNames, check: check}
+}
+
+type funcChecker struct {
+	argNames []string
+	check    func(t TestingT, got any, args []any)
+}
+
+func (c *funcChecker) ArgNames() []string {
+	return c.argNames
+}
+
+func (c *funcChecker) Check(got any, args []any, _ func(key string, value any)) error {
+	rec := &RecordingT{}
+	c.check(rec, got, args)
+	if err := rec.Err(); err != nil {
+		return errors.New(strings.TrimSuffix(err.Error(), ";"))
+	}
+	return nil
+}
+
+// checkerFailure runs c and renders a failure message if got doesn't pass,
+// or returns "" if it does.
+func checkerFailure(c Checker, got any, args []any, cfg *config) string {
+	names := c.ArgNames()
+	if len(names) != len(args)+1 {
+		return fmt.Sprintf("invalid number of arguments: got %d; want %d;", len(args), len(names)-1)
+	}
+
+	var notes []string
+	err := c.Check(got, args, func(key string, value any) {
+		notes = append(notes, fmt.Sprintf("%s: %s;", key, cfg.format(value)))
+	})
+	if err == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s;", names[0], cfg.format(got))
+	for i, arg := range args {
+		fmt.Fprintf(&b, " %s: %s;", names[i+1], cfg.format(arg))
+	}
+	fmt.Fprintf(&b, " error: %s;", err)
+	for _, note := range notes {
+		b.WriteString(" " + note)
+	}
+	return b.String()
+}
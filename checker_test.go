@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"testing"
+)
+
+// equalsChecker mimics a quicktest checker, without importing quicktest.
+type equalsChecker struct{}
+
+func (equalsChecker) ArgNames() []string {
+	return []string{"got", "want"}
+}
+
+func (equalsChecker) Check(got any, args []any, note func(key string, value any)) error {
+	if got != args[0] {
+		note("hint", "compare with ==")
+		return errors.New("values are not equal")
+	}
+	return nil
+}
+
+func TestPasses(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"passes": {check: func(tb TestingT) { That(tb, 42).Passes(equalsChecker{}, []any{42}) }},
+		"fails": {
+			check: func(tb TestingT) { That(tb, 42).Passes(equalsChecker{}, []any{43}, "answer") },
+			msg:   `got: 42; want: 43; error: values are not equal; hint: "compare with =="; answer`,
+		},
+		"argument count": {
+			check: func(tb TestingT) { That(tb, 42).Passes(equalsChecker{}, nil) },
+			msg:   "invalid number of arguments: got 0; want 1;",
+		},
+		"chained": {
+			check: func(tb TestingT) {
+				That(tb, 42).Passes(equalsChecker{}, []any{42}).And().Equals(43)
+			},
+			msg: "got: 42; want: 43;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestAsChecker(t *testing.T) {
+	var c Checker = AsChecker([]string{"got", "prefix"}, func(t TestingT, got any, args []any) {
+		HasPrefix(t, got.(string), args[0].(string))
+	})
+
+	if names := c.ArgNames(); len(names) != 2 || names[1] != "prefix" {
+		t.Errorf("got: %q; want: [got prefix];", names)
+	}
+	if err := c.Check("hello", []any{"he"}, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err := c.Check("hello", []any{"lo"}, nil)
+	if want := `got: "hello"; want prefix: "lo"`; err == nil || err.Error() != want {
+		t.Errorf("got: %v; want: %q;", err, want)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		tb := &mockTB{}
+		That(tb, "hello").Passes(c, []any{"x"})
+		wantMsg := `got: "hello"; prefix: "x"; error: got: "hello"; want prefix: "x";`
+		if !tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ChildReportEnv names the environment variable through which [RunChild]
+// tells a child process where to report assertion failures.
+const ChildReportEnv = "ASSERT_CHILD_REPORT"
+
+// InChild reports whether the process was started by [RunChild].
+func InChild() bool {
+	return os.Getenv(ChildReportEnv) != ""
+}
+
+// ChildT is a [TestingT] for use in a child process started by [RunChild].
+// Failures are reported back to the parent test along with their call site.
+// Fatal failures exit the child process with status 1.
+//
+// A typical re-exec test looks like:
+//
+//	func TestExit(t *testing.T) {
+//		if assert.InChild() {
+//			ct := assert.NewChildT()
+//			assert.Equal(ct, run(), 0)
+//			os.Exit(0)
+//		}
+//		cmd := exec.Command(os.Args[0], "-test.run=^TestExit$")
+//		assert.Nil(t, assert.RunChild(t, cmd))
+//	}
+type ChildT struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewChildT returns a [ChildT] reporting to the parent test.
+func NewChildT() *ChildT {
+	return &ChildT{path: os.Getenv(ChildReportEnv)}
+}
+
+func (c *ChildT) Helper() {}
+
+func (c *ChildT) Error(args ...any) {
+	c.report(SeverityError, fmt.Sprint(args...))
+}
+
+func (c *ChildT) Errorf(format string, args ...any) {
+	c.report(SeverityError, fmt.Sprintf(format, args...))
+}
+
+func (c *ChildT) Fatal(args ...any) {
+	c.report(SeverityFatal, fmt.Sprint(args...))
+	os.Exit(1)
+}
+
+func (c *ChildT) Fatalf(format string, args ...any) {
+	c.report(SeverityFatal, fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+func (c *ChildT) report(severity Severity, msg string) {
+	f := Failure{Severity: severity, Message: msg}
+	f.File, f.Line = callSite()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := appendFailure(c.path, f); err != nil {
+		// Without a report file, stderr is the only way left to surface the
+		// failure.
+		fmt.Fprintf(os.Stderr, "%s:%d: %s\n", f.File, f.Line, f.Message)
+	}
+}
+
+func appendFailure(path string, f Failure) error {
+	if path == "" {
+		return fmt.Errorf("%s is not set", ChildReportEnv)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(file).Encode(f); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// callSite returns the location of the first caller outside this package,
+// which is where the failing assertion was called.
+func callSite() (string, int) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		inPackage := strings.HasPrefix(frame.Function, packagePath+".") &&
+			!strings.HasSuffix(frame.File, "_test.go")
+		if !inPackage || !more {
+			return filepath.Base(frame.File), frame.Line
+		}
+	}
+}
+
+// packagePath is the import path of this package.
+var packagePath = reflect.TypeFor[ChildT]().PkgPath()
+
+// RunChild runs cmd as a child process that reports assertion failures
+// through a [ChildT], and reports each of them to t with its original call
+// site. It returns the result of running cmd, so the caller can also assert on
+// the exit status.
+func RunChild(t TestingT, cmd *exec.Cmd, opts ...any) error {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	dir, err := os.MkdirTemp("", "assert-child")
+	if err != nil {
+		t.Fatalf("unable to create child report: %s;%s", err, cfg.msg())
+		return err
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "report.jsonl")
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, ChildReportEnv+"="+path)
+	runErr := cmd.Run()
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return runErr
+	} else if err != nil {
+		t.Fatalf("unable to read child report: %s;%s", err, cfg.msg())
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var f Failure
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			t.Errorf("malformed child report line %q: %s", scanner.Text(), err)
+			continue
+		}
+		t.Errorf("%s:%d: %s%s", f.File, f.Line, f.Message, cfg.msg())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Errorf("unable to read child report: %s", err)
+	}
+	return runErr
+}
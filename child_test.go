@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestRunChild(t *testing.T) {
+	if InChild() {
+		ct := NewChildT()
+		ct.Errorf("soft failure")
+		Equal(ct, 1, 2)
+		ct.Errorf("not reached")
+		os.Exit(0)
+	}
+
+	rec := &RecordingT{}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunChild$")
+	err := RunChild(rec, cmd)
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Errorf("got: %v; want exit status 1", err)
+	}
+
+	failures := rec.Failures()
+	if len(failures) != 2 {
+		t.Fatalf("got: %d failures; want: 2; %v", len(failures), failures)
+	}
+	for i, want := range []string{": soft failure", ": got: 1; want: 2;"} {
+		msg := failures[i].Message
+		if !strings.HasPrefix(msg, "child_test.go:") || !strings.HasSuffix(msg, want) {
+			t.Errorf("got: %q; want child_test.go:<line>%s", msg, want)
+		}
+		if failures[i].Severity != SeverityError {
+			t.Errorf("got: %v; want: %v;", failures[i].Severity, SeverityError)
+		}
+	}
+}
+
+func TestRunChildPassing(t *testing.T) {
+	if InChild() {
+		Equal(NewChildT(), 1, 1)
+		os.Exit(0)
+	}
+
+	rec := &RecordingT{}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunChildPassing$")
+	if err := RunChild(rec, cmd); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if Failed(rec) {
+		t.Errorf("failed: %v", rec.Failures())
+	}
+}
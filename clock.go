@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for polling assertions such as [Eventually],
+// [Never] and [Consistently].
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// WithClock makes polling assertions use c instead of the system clock.
+func WithClock(c Clock) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.clk = c
+	})
+}
+
+// clock returns the clock set by WithClock, or the system clock.
+func (c *config) clock() Clock {
+	if c.clk == nil {
+		return systemClock{}
+	}
+	return c.clk
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time        { return time.Now() }
+func (systemClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// FakeClock is a [Clock] whose time only moves when told to. Sleep returns
+// immediately after advancing the clock by the given duration, so polling
+// assertions run through their whole budget without waiting. It is safe for
+// concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep advances the clock by d and returns immediately.
+func (c *FakeClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+// Advance moves the clock forward by d, or backward if d is negative.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
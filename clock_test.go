@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+	c.Sleep(time.Hour)
+	c.Advance(time.Minute)
+	if got, want := c.Now(), start.Add(61*time.Minute); !got.Equal(want) {
+		t.Errorf("got: %s; want: %s;", got, want)
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		check func(tb TestingT, c *FakeClock)
+		msg   string
+	}{
+		"eventually": {
+			check: func(tb TestingT, c *FakeClock) {
+				ready := start.Add(time.Minute)
+				Eventually(tb, func() bool { return !c.Now().Before(ready) }, time.Hour, time.Second, WithClock(c))
+			},
+		},
+		"eventually times out": {
+			check: func(tb TestingT, c *FakeClock) {
+				Eventually(tb, func() bool { return false }, time.Hour, 10*time.Minute, WithClock(c))
+			},
+			msg: "got: condition still false; want: true within 1h0m0s;\n" +
+				"  timeout:  1h0m0s\n" +
+				"  elapsed:  1h0m0s\n" +
+				"  attempts: 7",
+		},
+		"never": {
+			check: func(tb TestingT, c *FakeClock) {
+				Never(tb, func() bool { return c.Now().Sub(start) > 90*time.Second }, time.Hour, time.Minute, WithClock(c))
+			},
+			msg: "got: condition true after 2m0s; want: false throughout 1h0m0s;\n" +
+				"  window:   1h0m0s\n" +
+				"  elapsed:  2m0s\n" +
+				"  attempts: 3",
+		},
+		"consistently": {
+			check: func(tb TestingT, c *FakeClock) {
+				Consistently(tb, func() bool { return c.Now().Sub(start) < 30*time.Second }, time.Minute, 20*time.Second, WithClock(c))
+			},
+			msg: "got: condition false at 2025-01-01T00:00:40Z, after 40s; want: true throughout 1m0s;\n" +
+				"  window:   1m0s\n" +
+				"  elapsed:  40s\n" +
+				"  attempts: 3",
+		},
+		"eventually with backoff": {
+			check: func(tb TestingT, c *FakeClock) {
+				EventuallyWith(tb, func() bool { return false },
+					Backoff{Timeout: time.Minute, Initial: time.Second, Multiplier: 2}, WithClock(c))
+			},
+			// Attempts at 0s, 1s, 3s, 7s, 15s, 31s and 60s.
+			msg: "got: condition still false; want: true within 1m0s;\n" +
+				"  timeout:  1m0s\n" +
+				"  elapsed:  1m0s\n" +
+				"  attempts: 7",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb, NewFakeClock(start))
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
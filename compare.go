@@ -0,0 +1,314 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// number is the set of types accepted by numeric assertions.
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func Greater[T cmp.Ordered](t TestingT, got, threshold T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got > threshold) {
+		t.Fatalf("got: %s; want > %s;%s", cfg.format(got), cfg.format(threshold), cfg.msg())
+	}
+}
+
+func GreaterOrEqual[T cmp.Ordered](t TestingT, got, threshold T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got >= threshold) {
+		t.Fatalf("got: %s; want >= %s;%s", cfg.format(got), cfg.format(threshold), cfg.msg())
+	}
+}
+
+func Less[T cmp.Ordered](t TestingT, got, threshold T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got < threshold) {
+		t.Fatalf("got: %s; want < %s;%s", cfg.format(got), cfg.format(threshold), cfg.msg())
+	}
+}
+
+func LessOrEqual[T cmp.Ordered](t TestingT, got, threshold T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got <= threshold) {
+		t.Fatalf("got: %s; want <= %s;%s", cfg.format(got), cfg.format(threshold), cfg.msg())
+	}
+}
+
+func Positive[T number](t TestingT, got T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got > 0) {
+		t.Fatalf("got: %s; want > 0;%s", cfg.format(got), cfg.msg())
+	}
+}
+
+func Negative[T number](t TestingT, got T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got < 0) {
+		t.Fatalf("got: %s; want < 0;%s", cfg.format(got), cfg.msg())
+	}
+}
+
+func NonNegative[T number](t TestingT, got T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !(got >= 0) {
+		t.Fatalf("got: %s; want >= 0;%s", cfg.format(got), cfg.msg())
+	}
+}
+
+// Between asserts that low <= got <= high, or low < got < high if the
+// [Exclusive] option is given.
+func Between[T cmp.Ordered](t TestingT, got, low, high T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if cfg.exclusive {
+		if !(low < got && got < high) {
+			t.Fatalf("got: %s; want in (%s, %s);%s",
+				cfg.format(got), cfg.format(low), cfg.format(high), cfg.msg())
+		}
+	} else if !(low <= got && got <= high) {
+		t.Fatalf("got: %s; want in [%s, %s];%s",
+			cfg.format(got), cfg.format(low), cfg.format(high), cfg.msg())
+	}
+}
+
+// InDelta asserts that got is within delta of want. NaN values never match.
+func InDelta[T number](t TestingT, got, want T, delta float64, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	diff := math.Abs(float64(got) - float64(want))
+	if !(diff <= delta) {
+		t.Fatalf("got: %s; want: %s ± %v; diff: %v;%s",
+			cfg.format(got), cfg.format(want), delta, diff, cfg.msg())
+	}
+}
+
+// InDeltaComplex asserts that both the real and the imaginary parts of got
+// are within delta of those of want. NaN values never match.
+func InDeltaComplex(t TestingT, got, want complex128, delta float64, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	dr, di := math.Abs(real(got)-real(want)), math.Abs(imag(got)-imag(want))
+	if !(dr <= delta && di <= delta) {
+		t.Fatalf("got: %s; want: %s ± %v; diff: %s;%s",
+			cfg.format(got), cfg.format(want), delta, cfg.format(complex(dr, di)), cfg.msg())
+	}
+}
+
+// InDeltaSlice asserts that got and want have the same length, and that each
+// element of got is within delta of the corresponding element of want.
+func InDeltaSlice[T number](t TestingT, got, want []T, delta float64, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(got) != len(want) {
+		t.Fatalf("got: %d elements; want: %d elements;%s", len(got), len(want), cfg.msg())
+		return
+	}
+
+	var mismatches []string
+	stats := &sliceStats{total: len(got)}
+	for i := range got {
+		if diff := math.Abs(float64(got[i]) - float64(want[i])); !(diff <= delta) {
+			stats.add(i, float64(got[i])-float64(want[i]))
+			mismatches = append(mismatches, fmt.Sprintf("index %d: got: %s; want: %s ± %v; diff: %v",
+				i, cfg.format(got[i]), cfg.format(want[i]), delta, diff))
+		}
+	}
+	switch {
+	case len(mismatches) > maxListedMismatches:
+		// Summarize before listing, as a long listing hides the overall picture.
+		t.Fatalf("%s; %s; and %d more;%s", stats, strings.Join(mismatches[:maxListedMismatches], "; "),
+			len(mismatches)-maxListedMismatches, cfg.msg())
+	case len(mismatches) > 0:
+		t.Fatalf("%d of %d elements differ; %s;%s",
+			len(mismatches), len(got), strings.Join(mismatches, "; "), cfg.msg())
+	}
+}
+
+// sliceStats summarizes the differing elements of two numeric slices.
+type sliceStats struct {
+	differ, total int
+	first, last   int
+	maxAbs        float64
+	maxAt         int
+	sum           float64
+}
+
+// add records that the elements at index i differ by diff.
+func (s *sliceStats) add(i int, diff float64) {
+	if s.differ == 0 {
+		s.first = i
+	}
+	s.differ++
+	s.last = i
+	s.sum += diff
+	if abs := math.Abs(diff); abs > s.maxAbs || s.differ == 1 {
+		s.maxAbs, s.maxAt = abs, i
+	}
+}
+
+func (s *sliceStats) String() string {
+	return fmt.Sprintf("%d of %d elements differ; first at [%d]; last at [%d]; max |diff|: %v at [%d]; mean diff: %v",
+		s.differ, s.total, s.first, s.last, s.maxAbs, s.maxAt, s.sum/float64(s.differ))
+}
+
+// numericSliceStats summarizes how got and want differ if both are numeric
+// slices or arrays of the same, large length. Elements are compared as by
+// deepEqual, so comparison options apply.
+func numericSliceStats(got, want any, cfg *config) (*sliceStats, bool) {
+	g, w := reflect.ValueOf(got), reflect.ValueOf(want)
+	if !g.IsValid() || !w.IsValid() || g.Type() != w.Type() {
+		return nil, false
+	}
+	if k := g.Kind(); k != reflect.Slice && k != reflect.Array {
+		return nil, false
+	}
+	if g.Len() != w.Len() || g.Len() <= maxListedMismatches {
+		return nil, false
+	}
+	toFloat := numericFloat(g.Type().Elem().Kind())
+	if toFloat == nil {
+		return nil, false
+	}
+
+	d := &deepComparer{cfg: cfg}
+	stats := &sliceStats{total: g.Len()}
+	for i := range g.Len() {
+		if !d.compare(g.Index(i), w.Index(i)) {
+			stats.add(i, toFloat(g.Index(i))-toFloat(w.Index(i)))
+		}
+	}
+	return stats, stats.differ > 0
+}
+
+// numericFloat returns a conversion to float64 for values of kind k, or nil
+// if k is not a real number kind.
+func numericFloat(k reflect.Kind) func(reflect.Value) float64 {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value) float64 { return float64(v.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(v reflect.Value) float64 { return float64(v.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(v reflect.Value) float64 { return v.Float() }
+	}
+	return nil
+}
+
+// InEpsilon asserts that the relative error between got and want, that is
+// |got-want| / |want|, is at most epsilon.
+//
+// If want is zero the relative error is undefined, and got must instead be
+// within epsilon of zero. NaN values never match. Infinite values only match
+// an infinity of the same sign.
+func InEpsilon[T number](t TestingT, got, want T, epsilon float64, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	g, w := float64(got), float64(want)
+	switch {
+	case math.IsNaN(g) || math.IsNaN(w):
+		t.Fatalf("got: %s; want: %s; NaN has no relative error;%s",
+			cfg.format(got), cfg.format(want), cfg.msg())
+	case math.IsInf(g, 0) || math.IsInf(w, 0):
+		if g != w {
+			t.Fatalf("got: %s; want: %s;%s", cfg.format(got), cfg.format(want), cfg.msg())
+		}
+	case w == 0:
+		if !(math.Abs(g) <= epsilon) {
+			t.Fatalf("got: %s; want: 0 ± %v;%s", cfg.format(got), epsilon, cfg.msg())
+		}
+	default:
+		if rel := math.Abs(g-w) / math.Abs(w); !(rel <= epsilon) {
+			t.Fatalf("got: %s; want: %s; relative error: %v > %v;%s",
+				cfg.format(got), cfg.format(want), rel, epsilon, cfg.msg())
+		}
+	}
+}
+
+// IsSorted asserts that s is sorted in ascending order. NaN values sort
+// before any other value, as with [slices.Sort].
+func IsSorted[T cmp.Ordered](t TestingT, s []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	for i := 1; i < len(s); i++ {
+		if cmp.Less(s[i], s[i-1]) {
+			t.Fatalf("got: %s; want: sorted; out of order at index %d: %s after %s;%s",
+				cfg.format(s), i, cfg.format(s[i]), cfg.format(s[i-1]), cfg.msg())
+			return
+		}
+	}
+}
+
+// IsSortedFunc asserts that s is sorted in the order defined by less.
+func IsSortedFunc[T any](t TestingT, s []T, less func(a, b T) bool, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			t.Fatalf("got: %s; want: sorted; out of order at index %d: %s after %s;%s",
+				cfg.format(s), i, cfg.format(s[i]), cfg.format(s[i-1]), cfg.msg())
+			return
+		}
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOrdered(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"greater":          {check: func(tb TestingT) { Greater(tb, 6, 5) }},
+		"greater string":   {check: func(tb TestingT) { Greater(tb, "b", "a") }},
+		"greater or equal": {check: func(tb TestingT) { GreaterOrEqual(tb, 5, 5) }},
+		"less":             {check: func(tb TestingT) { Less(tb, 4.5, 5) }},
+		"less or equal":    {check: func(tb TestingT) { LessOrEqual(tb, 5, 5) }},
+		"not greater": {
+			check: func(tb TestingT) { Greater(tb, 3, 5) },
+			msg:   "got: 3; want > 5;",
+		},
+		"equal not greater": {
+			check: func(tb TestingT) { Greater(tb, 5, 5) },
+			msg:   "got: 5; want > 5;",
+		},
+		"not greater or equal": {
+			check: func(tb TestingT) { GreaterOrEqual(tb, "a", "b") },
+			msg:   `got: "a"; want >= "b";`,
+		},
+		"not less": {
+			check: func(tb TestingT) { Less(tb, 5, 5) },
+			msg:   "got: 5; want < 5;",
+		},
+		"not less or equal": {
+			check: func(tb TestingT) { LessOrEqual(tb, 6, 5) },
+			msg:   "got: 6; want <= 5;",
+		},
+		"NaN": {
+			check: func(tb TestingT) { GreaterOrEqual(tb, math.NaN(), 0) },
+			msg:   "got: NaN; want >= 0;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"positive":          {check: func(tb TestingT) { Positive(tb, 1) }},
+		"positive float":    {check: func(tb TestingT) { Positive(tb, 0.001) }},
+		"negative":          {check: func(tb TestingT) { Negative(tb, int8(-1)) }},
+		"non-negative":      {check: func(tb TestingT) { NonNegative(tb, 0) }},
+		"non-negative uint": {check: func(tb TestingT) { NonNegative(tb, uint(0)) }},
+		"zero not positive": {
+			check: func(tb TestingT) { Positive(tb, 0) },
+			msg:   "got: 0; want > 0;",
+		},
+		"not negative": {
+			check: func(tb TestingT) { Negative(tb, 3.5) },
+			msg:   "got: 3.5; want < 0;",
+		},
+		"not non-negative": {
+			check: func(tb TestingT) { NonNegative(tb, -1) },
+			msg:   "got: -1; want >= 0;",
+		},
+		"NaN": {
+			check: func(tb TestingT) { NonNegative(tb, math.NaN()) },
+			msg:   "got: NaN; want >= 0;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"inside":      {check: func(tb TestingT) { Between(tb, 5, 1, 10) }},
+		"lower bound": {check: func(tb TestingT) { Between(tb, 1, 1, 10) }},
+		"upper bound": {check: func(tb TestingT) { Between(tb, 10, 1, 10) }},
+		"string":      {check: func(tb TestingT) { Between(tb, "b", "a", "c") }},
+		"exclusive":   {check: func(tb TestingT) { Between(tb, 5, 1, 10, Exclusive()) }},
+		"above": {
+			check: func(tb TestingT) { Between(tb, 42, 1, 10) },
+			msg:   "got: 42; want in [1, 10];",
+		},
+		"below": {
+			check: func(tb TestingT) { Between(tb, 0.5, 1, 10, "oops") },
+			msg:   "got: 0.5; want in [1, 10]; oops",
+		},
+		"exclusive bound": {
+			check: func(tb TestingT) { Between(tb, 10, 1, 10, Exclusive()) },
+			msg:   "got: 10; want in (1, 10);",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestInDelta(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":       {check: func(tb TestingT) { InDelta(tb, 1.0, 1.0, 0) }},
+		"within":      {check: func(tb TestingT) { InDelta(tb, 1.05, 1.0, 0.1) }},
+		"at boundary": {check: func(tb TestingT) { InDelta(tb, 12, 10, 2) }},
+		"float32":     {check: func(tb TestingT) { InDelta(tb, float32(0.1), float32(0.10001), 0.001) }},
+		"unsigned":    {check: func(tb TestingT) { InDelta(tb, uint(3), uint(5), 2) }},
+		"outside": {
+			check: func(tb TestingT) { InDelta(tb, 1.5, 1.0, 0.1) },
+			msg:   "got: 1.5; want: 1 ± 0.1; diff: 0.5;",
+		},
+		"integer outside": {
+			check: func(tb TestingT) { InDelta(tb, 3, 10, 2) },
+			msg:   "got: 3; want: 10 ± 2; diff: 7;",
+		},
+		"NaN": {
+			check: func(tb TestingT) { InDelta(tb, math.NaN(), math.NaN(), 1) },
+			msg:   "got: NaN; want: NaN ± 1; diff: NaN;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestInDeltaComplex(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":  {check: func(tb TestingT) { InDeltaComplex(tb, 1+2i, 1+2i, 0) }},
+		"within": {check: func(tb TestingT) { InDeltaComplex(tb, 1.05+2i, 1-0.05i+2i, 0.1) }},
+		"imaginary outside": {
+			check: func(tb TestingT) { InDeltaComplex(tb, 1+2i, 1+2.5i, 0.1) },
+			msg:   "got: 1+2i; want: 1+2.5i ± 0.1; diff: 0+0.5i;",
+		},
+		"real outside": {
+			check: func(tb TestingT) { InDeltaComplex(tb, -1-2i, 1-2i, 0.1) },
+			msg:   "got: -1-2i; want: 1-2i ± 0.1; diff: 2+0i;",
+		},
+		"NaN": {
+			check: func(tb TestingT) { InDeltaComplex(tb, complex(math.NaN(), 0), 0, 1) },
+			msg:   "got: NaN+0i; want: 0+0i ± 1; diff: NaN+0i;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":  {check: func(tb TestingT) { InDeltaSlice(tb, []float64{1, 2}, []float64{1, 2}, 0) }},
+		"within": {check: func(tb TestingT) { InDeltaSlice(tb, []float64{1.05, 2}, []float64{1, 2.05}, 0.1) }},
+		"empty":  {check: func(tb TestingT) { InDeltaSlice(tb, []int{}, nil, 0) }},
+		"length": {
+			check: func(tb TestingT) { InDeltaSlice(tb, []float64{1}, []float64{1, 2}, 0.1) },
+			msg:   "got: 1 elements; want: 2 elements;",
+		},
+		"outside": {
+			check: func(tb TestingT) { InDeltaSlice(tb, []float64{1, 2.5, 3, 5}, []float64{1, 2, 3, 4}, 0.1) },
+			msg:   "2 of 4 elements differ; index 1: got: 2.5; want: 2 ± 0.1; diff: 0.5; index 3: got: 5; want: 4 ± 0.1; diff: 1;",
+		},
+		"outside many": {
+			check: func(tb TestingT) {
+				got := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+				InDeltaSlice(tb, got, make([]int, 12), 0.5)
+			},
+			msg: "11 of 12 elements differ; first at [1]; last at [11]; max |diff|: 11 at [11]; mean diff: 6; " +
+				"index 1: got: 1; want: 0 ± 0.5; diff: 1; index 2: got: 2; want: 0 ± 0.5; diff: 2; " +
+				"index 3: got: 3; want: 0 ± 0.5; diff: 3; index 4: got: 4; want: 0 ± 0.5; diff: 4; " +
+				"index 5: got: 5; want: 0 ± 0.5; diff: 5; index 6: got: 6; want: 0 ± 0.5; diff: 6; " +
+				"index 7: got: 7; want: 0 ± 0.5; diff: 7; index 8: got: 8; want: 0 ± 0.5; diff: 8; " +
+				"index 9: got: 9; want: 0 ± 0.5; diff: 9; index 10: got: 10; want: 0 ± 0.5; diff: 10; and 1 more;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestInEpsilon(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":         {check: func(tb TestingT) { InEpsilon(tb, 100, 100, 0) }},
+		"within":        {check: func(tb TestingT) { InEpsilon(tb, 101.0, 100.0, 0.01) }},
+		"large":         {check: func(tb TestingT) { InEpsilon(tb, 1.0001e20, 1e20, 0.001) }},
+		"negative":      {check: func(tb TestingT) { InEpsilon(tb, -99.5, -100.0, 0.01) }},
+		"zero want":     {check: func(tb TestingT) { InEpsilon(tb, 1e-9, 0, 1e-6) }},
+		"same infinity": {check: func(tb TestingT) { InEpsilon(tb, math.Inf(1), math.Inf(1), 0.1) }},
+		"outside": {
+			check: func(tb TestingT) { InEpsilon(tb, 105.0, 100.0, 0.01) },
+			msg:   "got: 105; want: 100; relative error: 0.05 > 0.01;",
+		},
+		"zero want outside": {
+			check: func(tb TestingT) { InEpsilon(tb, 0.5, 0, 0.1) },
+			msg:   "got: 0.5; want: 0 ± 0.1;",
+		},
+		"NaN": {
+			check: func(tb TestingT) { InEpsilon(tb, math.NaN(), 1, 0.1) },
+			msg:   "got: NaN; want: 1; NaN has no relative error;",
+		},
+		"different infinity": {
+			check: func(tb TestingT) { InEpsilon(tb, math.Inf(-1), math.Inf(1), 0.1) },
+			msg:   "got: -Inf; want: +Inf;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	type item struct {
+		name string
+		rank int
+	}
+	byRank := func(a, b item) bool { return a.rank < b.rank }
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"sorted":     {check: func(tb TestingT) { IsSorted(tb, []int{1, 2, 2, 3}) }},
+		"empty":      {check: func(tb TestingT) { IsSorted(tb, []string(nil)) }},
+		"NaN first":  {check: func(tb TestingT) { IsSorted(tb, []float64{math.NaN(), 1, 2}) }},
+		"sorted fn":  {check: func(tb TestingT) { IsSortedFunc(tb, []item{{"a", 1}, {"b", 1}, {"c", 2}}, byRank) }},
+		"descending": {check: func(tb TestingT) { IsSortedFunc(tb, []int{3, 2, 1}, func(a, b int) bool { return a > b }) }},
+		"unsorted": {
+			check: func(tb TestingT) { IsSorted(tb, []int{1, 3, 2, 0}) },
+			msg:   "got: []int{1, 3, 2, 0}; want: sorted; out of order at index 2: 2 after 3;",
+		},
+		"NaN last": {
+			check: func(tb TestingT) { IsSorted(tb, []float64{1, math.NaN()}, "scores") },
+			msg:   "got: []float64{1, NaN}; want: sorted; out of order at index 1: NaN after 1; scores",
+		},
+		"unsorted fn": {
+			check: func(tb TestingT) { IsSortedFunc(tb, []item{{"a", 2}, {"b", 1}}, byRank) },
+			msg:   `got: []assert.item{assert.item{name:"a", rank:2}, assert.item{name:"b", rank:1}}; want: sorted; out of order at index 1: assert.item{name:"b", rank:1} after assert.item{name:"a", rank:2};`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
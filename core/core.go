@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package core provides the building blocks of package assert, for writing
+// domain-specific assertion packages that behave like it: the same option
+// handling, value formatting, equality rules and failure messages.
+//
+// The API of this package follows the same compatibility rules as package
+// assert: exported identifiers are not removed or changed in incompatible
+// ways. The exact text of rendered values may improve between releases.
+//
+// A derived assertion follows the same pattern as those of package assert:
+//
+//	func PriceEqual(t core.TestingT, got, want Price, opts ...any) {
+//		if ht, ok := t.(core.HelperT); ok {
+//			ht.Helper()
+//		}
+//		cfg := core.NewConfig(t, opts...)
+//
+//		if !core.Equal(got.Amount, want.Amount, cfg) {
+//			core.Fatalf(t, cfg, "got: %s; want: %s;", cfg.Format(got), cfg.Format(want))
+//		}
+//	}
+package core
+
+import (
+	"fmt"
+
+	"github.com/dropwhile/assert"
+	"github.com/dropwhile/assert/internal/bridge"
+)
+
+// TestingT is the interface through which assertions report failures.
+type TestingT = assert.TestingT
+
+// Option configures a single assertion call.
+type Option = assert.Option
+
+// Failure is a single assertion failure, as recorded by [assert.RecordingT].
+type Failure = assert.Failure
+
+// Config holds the settings of a single assertion call, built from its
+// trailing arguments by [NewConfig].
+type Config struct {
+	impl any
+}
+
+// NewConfig builds a Config from the trailing arguments of an assertion:
+// [Option] values and plain string messages. Arguments of any other type are
+// reported to t as a failure. It must be called directly by the assertion,
+// which is then tallied in the assertion usage report.
+func NewConfig(t TestingT, opts ...any) *Config {
+	if ht, ok := t.(HelperT); ok {
+		ht.Helper()
+	}
+	return &Config{impl: bridge.NewConfig(t, opts)}
+}
+
+// Format renders v as in the failure messages of package assert, honoring
+// options such as [assert.FloatFormat].
+func (c *Config) Format(v any) string {
+	return bridge.Format(c.impl, v)
+}
+
+// Msg renders the user supplied messages, to be appended to a failure
+// message. It is empty if there are none, and starts with a space otherwise.
+func (c *Config) Msg() string {
+	return bridge.Msg(c.impl)
+}
+
+// Equal reports whether got and want are equal under the rules of
+// [assert.Equal], honoring comparison options such as [assert.EquateNaNs].
+func Equal[T any](got, want T, c *Config) bool {
+	return bridge.Equal(c.impl, got, want)
+}
+
+// HelperT is implemented by a [TestingT] that can mark helper functions, as
+// [testing.T] does. Assertions should call Helper first thing when t
+// implements it, so that failures are reported at the caller's line. Since
+// Helper marks its direct caller, this can't be wrapped in a function.
+type HelperT interface {
+	Helper()
+}
+
+// Fatalf reports a fatal failure to t, with the user supplied messages of c
+// appended.
+func Fatalf(t TestingT, c *Config, format string, args ...any) {
+	if ht, ok := t.(HelperT); ok {
+		ht.Helper()
+	}
+	t.Fatalf("%s%s", fmt.Sprintf(format, args...), c.Msg())
+}
+
+// Errorf reports a failure to t, with the user supplied messages of c
+// appended, and lets the test continue.
+func Errorf(t TestingT, c *Config, format string, args ...any) {
+	if ht, ok := t.(HelperT); ok {
+		ht.Helper()
+	}
+	t.Errorf("%s%s", fmt.Sprintf(format, args...), c.Msg())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dropwhile/assert"
+)
+
+type price struct {
+	Amount   float64
+	Currency string
+}
+
+// priceEqual is a derived assertion built on this package.
+func priceEqual(t TestingT, got, want price, opts ...any) {
+	if ht, ok := t.(HelperT); ok {
+		ht.Helper()
+	}
+	cfg := NewConfig(t, opts...)
+
+	if !Equal(got, want, cfg) {
+		Fatalf(t, cfg, "got: %s; want: %s;", cfg.Format(got), cfg.Format(want))
+	}
+}
+
+func TestDerivedAssertion(t *testing.T) {
+	testCases := map[string]struct {
+		got, want price
+		opts      []any
+		msg       string
+	}{
+		"equal": {got: price{1, "EUR"}, want: price{1, "EUR"}},
+		"options": {
+			got:  price{math.NaN(), "EUR"},
+			want: price{math.NaN(), "EUR"},
+			opts: []any{assert.EquateNaNs()},
+		},
+		"different": {
+			got:  price{1, "EUR"},
+			want: price{2, "EUR"},
+			opts: []any{"checkout"},
+			msg:  `got: core.price{Amount:1, Currency:"EUR"}; want: core.price{Amount:2, Currency:"EUR"}; checkout`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rec := &assert.RecordingT{}
+			priceEqual(rec, tc.got, tc.want, tc.opts...)
+			failures := rec.Failures()
+			if tc.msg == "" {
+				if len(failures) > 0 {
+					t.Errorf("failed: %v", failures)
+				}
+				return
+			}
+			if len(failures) != 1 || failures[0].Message != tc.msg || failures[0].Severity != assert.SeverityFatal {
+				t.Errorf("got: %v; want: fatal %q;", failures, tc.msg)
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	cfg := NewConfig(t, assert.FloatFormat('f', 2), "one", assert.Msgf("attempt %d", 2))
+	if got, want := cfg.Format(1.0/3), "0.33"; got != want {
+		t.Errorf("got: %q; want: %q;", got, want)
+	}
+	if got, want := cfg.Msg(), " one; attempt 2"; got != want {
+		t.Errorf("got: %q; want: %q;", got, want)
+	}
+}
+
+func TestUnsupportedArgument(t *testing.T) {
+	rec := &assert.RecordingT{}
+	NewConfig(rec, "ctx", 3)
+	failures := rec.Failures()
+	want := "unsupported argument of type int; want an Option or a string message; use Msgf for formatted messages;"
+	if len(failures) != 1 || failures[0].Message != want || failures[0].Severity != assert.SeverityError {
+		t.Errorf("got: %v; want: error %q;", failures, want)
+	}
+}
+
+func TestErrorf(t *testing.T) {
+	rec := &assert.RecordingT{}
+	Errorf(rec, NewConfig(rec, "ctx"), "got: %d;", 1)
+	failures := rec.Failures()
+	if len(failures) != 1 || failures[0].Message != "got: 1; ctx" || failures[0].Severity != assert.SeverityError {
+		t.Errorf("got: %v; want: error %q;", failures, "got: 1; ctx")
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix && !windows
+
+package assert
+
+import (
+	"time"
+)
+
+// processCPUTime reports that CPU time can't be measured on this platform.
+func processCPUTime() (time.Duration, bool) {
+	return 0, false
+}
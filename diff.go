@@ -0,0 +1,260 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DiffToolEnv names the environment variable holding an external diff
+// command, such as "difft" or "delta". When set, large diffs are rendered by
+// running the command with the paths of two files holding got and want.
+// Multi-line strings are written as is; structs, slices, arrays and maps
+// compared by [Equal] are written with one field, element or entry per line.
+// The built-in rendering is used instead when the CI environment variable is
+// set, or if the command fails.
+const DiffToolEnv = "ASSERT_DIFF_TOOL"
+
+const (
+	// diffContext is the number of unchanged lines shown around each change.
+	diffContext = 3
+	// diffMaxCells bounds the size of the LCS table; larger inputs are shown
+	// as a single replaced block.
+	diffMaxCells = 1 << 22
+)
+
+const (
+	// diffToolMinLines is the number of lines from which diffs are handed to
+	// the external diff tool.
+	diffToolMinLines = 10
+	// diffToolTimeout bounds the run time of the external diff tool.
+	diffToolTimeout = 10 * time.Second
+)
+
+// renderDiff renders a diff of got against want, using the external diff
+// tool if one is configured.
+func renderDiff(got, want string) string {
+	if out, ok := externalDiff(got, want); ok {
+		return out
+	}
+	return lineDiff(got, want)
+}
+
+// diffTool returns the command named by [DiffToolEnv], or nil if there is
+// none or it should not be used.
+func diffTool() []string {
+	if os.Getenv("CI") != "" {
+		return nil
+	}
+	return strings.Fields(os.Getenv(DiffToolEnv))
+}
+
+// externalValueDiff renders got and want with valueText and hands them to the
+// external diff tool, and reports whether it produced a diff.
+func externalValueDiff(got, want any) (string, bool) {
+	if diffTool() == nil {
+		return "", false
+	}
+	g, ok1 := valueText(got)
+	w, ok2 := valueText(want)
+	if !ok1 || !ok2 {
+		return "", false
+	}
+	return externalDiff(g, w)
+}
+
+// valueText renders a struct, slice, array or map, or a pointer to one, with
+// one field, element or entry per line, so that diff tools line up its parts.
+// It reports whether v was such a value.
+func valueText(v any) (string, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	var lines []string
+	switch rv.Kind() {
+	case reflect.Struct:
+		for i := range rv.NumField() {
+			lines = append(lines, fmt.Sprintf("%s: %s,", rv.Type().Field(i).Name, formatValue(rv.Field(i))))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			lines = append(lines, formatValue(rv.Index(i))+",")
+		}
+	case reflect.Map:
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, compareValues)
+		for _, k := range keys {
+			lines = append(lines, fmt.Sprintf("%s: %s,", formatValue(k), formatValue(rv.MapIndex(k))))
+		}
+	default:
+		return "", false
+	}
+	if len(lines) == 0 {
+		return fmt.Sprintf("%v{}", rv.Type()), true
+	}
+	return fmt.Sprintf("%v{\n\t%s\n}", rv.Type(), strings.Join(lines, "\n\t")), true
+}
+
+// externalDiff runs the diff tool named by [DiffToolEnv], and reports whether
+// it produced a diff.
+func externalDiff(got, want string) (string, bool) {
+	tool := diffTool()
+	if len(tool) == 0 {
+		return "", false
+	}
+	if strings.Count(got, "\n")+strings.Count(want, "\n") < diffToolMinLines {
+		return "", false
+	}
+
+	dir, err := os.MkdirTemp("", "assert-diff")
+	if err != nil {
+		return "", false
+	}
+	defer os.RemoveAll(dir)
+
+	gotPath, wantPath := filepath.Join(dir, "got"), filepath.Join(dir, "want")
+	if os.WriteFile(gotPath, []byte(got), 0o600) != nil || os.WriteFile(wantPath, []byte(want), 0o600) != nil {
+		return "", false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), diffToolTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, tool[0], append(tool[1:], gotPath, wantPath)...).Output()
+
+	// Most diff tools exit with a non-zero status when the inputs differ.
+	var exitErr *exec.ExitError
+	if (err != nil && !errors.As(err, &exitErr)) || ctx.Err() != nil || len(out) == 0 {
+		return "", false
+	}
+	return strings.TrimSuffix(string(out), "\n"), true
+}
+
+// lineDiff renders a line based diff of got against want. Lines only in got
+// are prefixed with "-", lines only in want with "+".
+func lineDiff(got, want string) string {
+	a := strings.Split(got, "\n")
+	b := strings.Split(want, "\n")
+
+	var out strings.Builder
+	out.WriteString("--- got\n+++ want\n")
+	ops := diffLines(a, b)
+	for i, op := range ops {
+		if op.kind == ' ' && !nearChange(ops, i) {
+			if i == 0 || nearChange(ops, i-1) {
+				out.WriteString("  ...\n")
+			}
+			continue
+		}
+		out.WriteByte(op.kind)
+		out.WriteByte(' ')
+		out.WriteString(op.line)
+		out.WriteByte('\n')
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// nearChange reports whether ops[i] is within diffContext lines of a change.
+func nearChange(ops []diffOp, i int) bool {
+	for j := max(0, i-diffContext); j <= min(len(ops)-1, i+diffContext); j++ {
+		if ops[j].kind != ' ' {
+			return true
+		}
+	}
+	return false
+}
+
+// diffLines computes the edit script turning a into b.
+func diffLines(a, b []string) []diffOp {
+	var ops []diffOp
+
+	// Trim the common prefix and suffix, which is cheap and usually shrinks
+	// the problem considerably.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ops = append(ops, diffOp{' ', a[prefix]})
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	tail := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	if (len(a)+1)*(len(b)+1) > diffMaxCells {
+		for _, l := range a {
+			ops = append(ops, diffOp{'-', l})
+		}
+		for _, l := range b {
+			ops = append(ops, diffOp{'+', l})
+		}
+	} else {
+		ops = append(ops, lcsDiff(a, b)...)
+	}
+
+	for _, l := range tail {
+		ops = append(ops, diffOp{' ', l})
+	}
+	return ops
+}
+
+// lcsDiff computes an edit script using a longest common subsequence table.
+func lcsDiff(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLineDiff(t *testing.T) {
+	testCases := map[string]struct {
+		got      string
+		want     string
+		expected string
+	}{
+		"changed line": {
+			got:  "a\nb\nc",
+			want: "a\nx\nc",
+			expected: "--- got\n+++ want\n" +
+				"  a\n- b\n+ x\n  c",
+		},
+		"added line": {
+			got:  "a\nc",
+			want: "a\nb\nc",
+			expected: "--- got\n+++ want\n" +
+				"  a\n+ b\n  c",
+		},
+		"removed line": {
+			got:  "a\nb\nc",
+			want: "a\nc",
+			expected: "--- got\n+++ want\n" +
+				"  a\n- b\n  c",
+		},
+		"collapsed context": {
+			got:  "1\n2\n3\n4\n5\n6\n7\n8\n9",
+			want: "1\n2\n3\n4\n5\n6\n7\n8\nx",
+			expected: "--- got\n+++ want\n" +
+				"  ...\n  6\n  7\n  8\n- 9\n+ x",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := lineDiff(tc.got, tc.want)
+			if got != tc.expected {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tc.expected)
+			}
+		})
+	}
+
+	t.Run("large input", func(t *testing.T) {
+		a := strings.Repeat("a\n", 5000)
+		b := strings.Repeat("b\n", 5000)
+		got := lineDiff(a, b)
+		if strings.Count(got, "\n- a") != 5000 || strings.Count(got, "\n+ b") != 5000 {
+			t.Errorf("unexpected diff of %d bytes", len(got))
+		}
+	})
+}
+
+func TestExternalDiff(t *testing.T) {
+	got := strings.Repeat("got\n", diffToolMinLines)
+	want := strings.Repeat("want\n", diffToolMinLines)
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "cat")
+		t.Setenv("CI", "")
+		out := renderDiff(got, want)
+		if out != strings.TrimSuffix(got+want, "\n") {
+			t.Errorf("got: %q; want output of external tool", out)
+		}
+	})
+
+	t.Run("small diff", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "cat")
+		t.Setenv("CI", "")
+		out := renderDiff("a", "b")
+		if out != lineDiff("a", "b") {
+			t.Errorf("got: %q; want built-in diff", out)
+		}
+	})
+
+	t.Run("CI", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "cat")
+		t.Setenv("CI", "true")
+		if out := renderDiff(got, want); out != lineDiff(got, want) {
+			t.Errorf("got: %q; want built-in diff", out)
+		}
+	})
+
+	t.Run("failing tool", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "assert-no-such-diff-tool")
+		t.Setenv("CI", "")
+		if out := renderDiff(got, want); out != lineDiff(got, want) {
+			t.Errorf("got: %q; want built-in diff", out)
+		}
+	})
+}
+
+func TestExternalValueDiff(t *testing.T) {
+	type row struct {
+		ID   int
+		name string
+	}
+	got := make([]row, diffToolMinLines)
+	want := make([]row, diffToolMinLines)
+	want[3].name = "x"
+
+	t.Run("composite values", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "cat")
+		t.Setenv("CI", "")
+		tb := &mockTB{}
+		Equal(tb, got, want, "rows")
+		g, _ := valueText(got)
+		w, _ := valueText(want)
+		if msg := "got and want differ; rows\n" + g + w; tb.msg != msg {
+			t.Errorf("got: %q; want: %q;", tb.msg, msg)
+		}
+	})
+
+	t.Run("no tool", func(t *testing.T) {
+		t.Setenv(DiffToolEnv, "")
+		tb := &mockTB{}
+		Equal(tb, got, want)
+		if strings.HasPrefix(tb.msg, "got and want differ;") {
+			t.Errorf("got: %q; want built-in rendering", tb.msg)
+		}
+	})
+}
+
+func TestValueText(t *testing.T) {
+	type pair struct {
+		A int
+		b string
+	}
+
+	testCases := map[string]struct {
+		value any
+		text  string
+	}{
+		"struct":        {value: pair{1, "x"}, text: "assert.pair{\n\tA: 1,\n\tb: \"x\",\n}"},
+		"pointer":       {value: &pair{1, "x"}, text: "assert.pair{\n\tA: 1,\n\tb: \"x\",\n}"},
+		"slice":         {value: []int{1, 2}, text: "[]int{\n\t1,\n\t2,\n}"},
+		"map":           {value: map[string]int{"b": 2, "a": 1}, text: "map[string]int{\n\t\"a\": 1,\n\t\"b\": 2,\n}"},
+		"empty":         {value: []int{}, text: "[]int{}"},
+		"not composite": {value: 42},
+		"nil pointer":   {value: (*pair)(nil)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			text, ok := valueText(tc.value)
+			if ok != (tc.text != "") {
+				t.Fatalf("got: %v; want: %v;", ok, tc.text != "")
+			}
+			if text != tc.text {
+				t.Errorf("got: %q; want: %q;", text, tc.text)
+			}
+		})
+	}
+}
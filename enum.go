@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CoversEnum asserts that handled includes every value of all, such as the
+// keys of a dispatch table against the constants of an enum, listing the
+// missing values on failure. The values of all can be produced from stringer
+// output with [StringerValues].
+func CoversEnum[T ~int](t TestingT, handled, all []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var missing []string
+	for _, v := range all {
+		if !slices.Contains(handled, v) {
+			missing = append(missing, enumName(v))
+		}
+	}
+	if len(missing) > 0 {
+		t.Fatalf("got: %d of %d enum values handled; missing: %s;%s",
+			len(all)-len(missing), len(all), strings.Join(missing, ", "), cfg.msg())
+	}
+}
+
+// StringerValues returns the values from lo to hi, inclusive, that have a
+// name as rendered by the String method generated by stringer. Values
+// without a name, which stringer renders as "T(n)", are skipped.
+func StringerValues[T interface {
+	~int
+	String() string
+}](lo, hi T) []T {
+	var values []T
+	for v := lo; v <= hi; v++ {
+		if !strings.HasSuffix(v.String(), "("+strconv.Itoa(int(v))+")") {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// enumName renders an enum value, with its number if it has a name.
+func enumName[T ~int](v T) string {
+	name := fmt.Sprint(v)
+	if n := strconv.Itoa(int(v)); name != n {
+		return name + " (" + n + ")"
+	}
+	return name
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+	_
+	black
+)
+
+// String mimics the output of stringer for color.
+func (c color) String() string {
+	switch c {
+	case red:
+		return "red"
+	case green:
+		return "green"
+	case blue:
+		return "blue"
+	case black:
+		return "black"
+	}
+	return "color(" + strconv.Itoa(int(c)) + ")"
+}
+
+func TestCoversEnum(t *testing.T) {
+	all := []color{red, green, blue, black}
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"covered": {check: func(tb TestingT) { CoversEnum(tb, []color{black, blue, green, red}, all) }},
+		"missing": {
+			check: func(tb TestingT) { CoversEnum(tb, []color{red, blue}, all, "handlers") },
+			msg:   "got: 2 of 4 enum values handled; missing: green (1), black (4); handlers",
+		},
+		"plain ints": {
+			check: func(tb TestingT) { CoversEnum(tb, []int{1}, []int{1, 2}) },
+			msg:   "got: 1 of 2 enum values handled; missing: 2;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestStringerValues(t *testing.T) {
+	got := StringerValues(red, color(10))
+	if want := []color{red, green, blue, black}; !slices.Equal(got, want) {
+		t.Errorf("got: %v; want: %v;", got, want)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// EquateNaNs makes equality assertions treat NaN values as equal to each
+// other, including NaNs nested in slices, maps and structs.
+func EquateNaNs() Option {
+	return optionFunc(func(c *config) {
+		c.equateNaNs = true
+	})
+}
+
+// EquateApprox makes equality assertions treat floating point values as equal
+// if they are within margin of each other, or if their difference is at most
+// frac times the smaller magnitude. It applies to every float encountered
+// during comparison, including those nested in slices, maps and structs.
+func EquateApprox(frac, margin float64) Option {
+	return optionFunc(func(c *config) {
+		c.approx = true
+		c.approxFrac = frac
+		c.approxMargin = margin
+	})
+}
+
+// EquateApproxTime makes equality assertions treat [time.Time] values as equal
+// if they are within d of each other, including times nested in slices, maps
+// and structs. Monotonic clock readings and locations are ignored, as with
+// [time.Time.Equal].
+func EquateApproxTime(d time.Duration) Option {
+	return optionFunc(func(c *config) {
+		c.approxTime = true
+		c.approxTimeDur = d.Abs()
+	})
+}
+
+// deep reports whether any option requires deepEqual rather than
+// [reflect.DeepEqual].
+func (c *config) deep() bool {
+	return c.equateNaNs || c.approx || c.approxTime
+}
+
+// deepEqual is a variant of [reflect.DeepEqual] that honors the comparison
+// options in cfg.
+func deepEqual(got, want any, cfg *config) bool {
+	d := &deepComparer{cfg: cfg}
+	return d.compare(addressable(reflect.ValueOf(got)), addressable(reflect.ValueOf(want)))
+}
+
+// addressable returns an addressable copy of v, so that unexported fields
+// reached from it can still be read with valueInterface.
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// valueInterface returns the value held by v, even if it was reached through
+// unexported fields, and reports whether it could be read.
+func valueInterface(v reflect.Value) (any, bool) {
+	if v.CanInterface() {
+		return v.Interface(), true
+	}
+	if v.CanAddr() {
+		return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem().Interface(), true
+	}
+	return nil, false
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// visit records a comparison in progress, to terminate on cyclic values.
+// Slices also record their length, as sub-slices of one backing array share
+// a data pointer but hold different elements.
+type visit struct {
+	a, b uintptr
+	n    int
+	typ  reflect.Type
+}
+
+type deepComparer struct {
+	cfg     *config
+	visited map[visit]bool
+}
+
+// compare reports whether v1 and v2 are deeply equal. Each call starts with
+// no recorded visits, since a visit only short-circuits comparisons that are
+// still in progress, and a comparer may be reused for unrelated values.
+func (d *deepComparer) compare(v1, v2 reflect.Value) bool {
+	d.visited = map[visit]bool{}
+	return d.equal(v1, v2)
+}
+
+func (d *deepComparer) equal(v1, v2 reflect.Value) bool {
+	if !v1.IsValid() || !v2.IsValid() {
+		return v1.IsValid() == v2.IsValid()
+	}
+	if v1.Type() != v2.Type() {
+		return false
+	}
+
+	switch v1.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		v := visit{a: v1.Pointer(), b: v2.Pointer(), typ: v1.Type()}
+		if v1.Kind() == reflect.Slice {
+			v.n = v1.Len()
+		}
+		if d.visited[v] {
+			return true
+		}
+		d.visited[v] = true
+	}
+
+	if d.cfg.approxTime && v1.Type() == timeType {
+		if equal, ok := d.equalTime(v1, v2); ok {
+			return equal
+		}
+	}
+
+	switch v1.Kind() {
+	case reflect.Array:
+		return d.equalElems(v1, v2)
+	case reflect.Slice:
+		return v1.Len() == v2.Len() && d.equalElems(v1, v2)
+	case reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return d.equal(v1.Elem(), v2.Elem())
+	case reflect.Pointer:
+		return d.equal(v1.Elem(), v2.Elem())
+	case reflect.Struct:
+		for i := range v1.NumField() {
+			if !d.equal(v1.Field(i), v2.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		iter := v1.MapRange()
+		for iter.Next() {
+			val2 := v2.MapIndex(iter.Key())
+			if !val2.IsValid() || !d.equal(iter.Value(), val2) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		// Like reflect.DeepEqual, functions are only equal if both are nil.
+		return v1.IsNil() && v2.IsNil()
+	case reflect.Float32, reflect.Float64:
+		return d.equalFloat(v1.Float(), v2.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c1, c2 := v1.Complex(), v2.Complex()
+		return d.equalFloat(real(c1), real(c2)) && d.equalFloat(imag(c1), imag(c2))
+	default:
+		return v1.Equal(v2)
+	}
+}
+
+func (d *deepComparer) equalElems(v1, v2 reflect.Value) bool {
+	for i := range v1.Len() {
+		if !d.equal(v1.Index(i), v2.Index(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalTime compares two time.Time values within the EquateApproxTime
+// tolerance, and reports whether their values could be read.
+func (d *deepComparer) equalTime(v1, v2 reflect.Value) (equal, ok bool) {
+	a, ok1 := valueInterface(v1)
+	b, ok2 := valueInterface(v2)
+	if !ok1 || !ok2 {
+		return false, false
+	}
+	return a.(time.Time).Sub(b.(time.Time)).Abs() <= d.cfg.approxTimeDur, true
+}
+
+func (d *deepComparer) equalFloat(x, y float64) bool {
+	if x == y {
+		return true
+	}
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return d.cfg.equateNaNs && math.IsNaN(x) && math.IsNaN(y)
+	}
+	if d.cfg.approx {
+		diff := math.Abs(x - y)
+		return diff <= d.cfg.approxMargin || diff <= d.cfg.approxFrac*math.Min(math.Abs(x), math.Abs(y))
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// floatHolder nests floats in exported and unexported fields.
+type floatHolder struct {
+	Val   float64
+	vals  []float64
+	inner *floatHolder
+}
+
+// cycle is a self-referencing type.
+type cycle struct {
+	next *cycle
+	val  float64
+}
+
+func TestDeepEqual(t *testing.T) {
+	now := time.Now()
+	c1 := &cycle{val: 1}
+	c1.next = c1
+	c2 := &cycle{val: 1}
+	c2.next = c2
+	s := []int{1, 2, 3}
+	w := []int{1, 9, 3}
+
+	// Without options that affect them, deepEqual must agree with
+	// reflect.DeepEqual.
+	testCases := map[string]struct {
+		got, want any
+		equal     bool
+	}{
+		"integers":        {got: 42, want: 42, equal: true},
+		"different types": {got: int32(42), want: int64(42)},
+		"strings":         {got: "a", want: "b"},
+		"nil":             {got: nil, want: nil, equal: true},
+		"nil vs value":    {got: nil, want: 42},
+		"nil vs empty":    {got: []int(nil), want: []int{}},
+		"slices":          {got: []int{1, 2}, want: []int{1, 2}, equal: true},
+		"slice lengths":   {got: []int{1, 2}, want: []int{1}},
+		"arrays":          {got: [2]int{1, 2}, want: [2]int{1, 3}},
+		"maps":            {got: map[string]int{"a": 1}, want: map[string]int{"a": 1}, equal: true},
+		"map values":      {got: map[string]int{"a": 1}, want: map[string]int{"a": 2}},
+		"map keys":        {got: map[string]int{"a": 1}, want: map[string]int{"b": 1}},
+		"structs":         {got: floatHolder{Val: 1, vals: []float64{2}}, want: floatHolder{Val: 1, vals: []float64{2}}, equal: true},
+		"struct fields":   {got: floatHolder{vals: []float64{2}}, want: floatHolder{vals: []float64{3}}},
+		"pointers":        {got: &floatHolder{Val: 1}, want: &floatHolder{Val: 1}, equal: true},
+		"interfaces":      {got: []any{1, "a"}, want: []any{1, "a"}, equal: true},
+		"time":            {got: now, want: now, equal: true},
+		"cycles":          {got: c1, want: c2, equal: true},
+		"funcs":           {got: (func())(nil), want: (func())(nil), equal: true},
+		"complex":         {got: complex(1, 2), want: complex(1, 3)},
+		"aliased sub-slices": {
+			got:  [][]int{s[:1], s[:2]},
+			want: [][]int{w[:1], w[:3]},
+		},
+		"aliased fields": {
+			got:  struct{ A, B []int }{s[:1], s[:2]},
+			want: struct{ A, B []int }{w[:1], w[:2]},
+		},
+		"aliased sub-slices equal": {
+			got:   [][]int{s[:1], s[:1]},
+			want:  [][]int{w[:1], w[:1]},
+			equal: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := deepEqual(tc.got, tc.want, parseConfig(EquateNaNs()))
+			if got != tc.equal {
+				t.Errorf("got: %v; want: %v;", got, tc.equal)
+			}
+		})
+	}
+}
+
+func TestEquateNaNs(t *testing.T) {
+	nan := math.NaN()
+
+	testCases := map[string]struct {
+		got, want any
+	}{
+		"float":         {got: nan, want: nan},
+		"float32":       {got: float32(nan), want: float32(nan)},
+		"slice":         {got: []float64{1, nan}, want: []float64{1, nan}},
+		"map":           {got: map[string]float64{"a": nan}, want: map[string]float64{"a": nan}},
+		"struct":        {got: floatHolder{Val: nan, vals: []float64{nan}}, want: floatHolder{Val: nan, vals: []float64{nan}}},
+		"nested struct": {got: &floatHolder{inner: &floatHolder{Val: nan}}, want: &floatHolder{inner: &floatHolder{Val: nan}}},
+		"complex":       {got: complex(nan, 1), want: complex(nan, 1)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			Equal(tb, tc.got, tc.want)
+			if !tb.failed {
+				t.Error("should have failed without EquateNaNs")
+			}
+
+			tb2 := &mockTB{}
+			Equal(tb2, tc.got, tc.want, EquateNaNs())
+			if tb2.failed {
+				t.Errorf("failed: %s", tb2.msg)
+			}
+		})
+	}
+
+	t.Run("NaN vs number", func(t *testing.T) {
+		tb := &mockTB{}
+		Equal(tb, []float64{nan}, []float64{1}, EquateNaNs())
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
+
+func TestEquateApprox(t *testing.T) {
+	testCases := map[string]struct {
+		got, want any
+		opt       Option
+		equal     bool
+	}{
+		"margin":           {got: 1.0, want: 1.05, opt: EquateApprox(0, 0.1), equal: true},
+		"outside margin":   {got: 1.0, want: 1.2, opt: EquateApprox(0, 0.1)},
+		"fraction":         {got: 1000.0, want: 1001.0, opt: EquateApprox(0.01, 0), equal: true},
+		"outside fraction": {got: 1000.0, want: 1100.0, opt: EquateApprox(0.01, 0)},
+		"nested": {
+			got:   floatHolder{Val: 1, vals: []float64{2.0000001}, inner: &floatHolder{Val: 3}},
+			want:  floatHolder{Val: 1.0000001, vals: []float64{2}, inner: &floatHolder{Val: 3.0000001}},
+			opt:   EquateApprox(0, 1e-6),
+			equal: true,
+		},
+		"map":      {got: map[string]float32{"a": 0.1}, want: map[string]float32{"a": 0.1000001}, opt: EquateApprox(1e-5, 0), equal: true},
+		"infinity": {got: math.Inf(1), want: math.Inf(1), opt: EquateApprox(0.1, 0.1), equal: true},
+		"NaN":      {got: math.NaN(), want: math.NaN(), opt: EquateApprox(0.1, 0.1)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			Equal(tb, tc.got, tc.want, tc.opt)
+			if tb.failed == tc.equal {
+				t.Errorf("got: failed=%v; want: failed=%v; %s", tb.failed, !tc.equal, tb.msg)
+			}
+		})
+	}
+}
+
+type event struct {
+	Name string
+	At   time.Time
+	seen time.Time
+}
+
+func TestEquateApproxTime(t *testing.T) {
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		got, want any
+		opt       Option
+		equal     bool
+	}{
+		"within":  {got: base, want: base.Add(500 * time.Millisecond), opt: EquateApproxTime(time.Second), equal: true},
+		"outside": {got: base, want: base.Add(2 * time.Second), opt: EquateApproxTime(time.Second)},
+		"before":  {got: base, want: base.Add(-time.Second), opt: EquateApproxTime(time.Second), equal: true},
+		"location": {
+			got:   base,
+			want:  base.In(time.FixedZone("UTC+1", 3600)),
+			opt:   EquateApproxTime(0),
+			equal: true,
+		},
+		"nested": {
+			got:   event{Name: "a", At: base, seen: base},
+			want:  event{Name: "a", At: base.Add(time.Microsecond), seen: base.Add(time.Microsecond)},
+			opt:   EquateApproxTime(time.Millisecond),
+			equal: true,
+		},
+		"nested unexported outside": {
+			got:  event{Name: "a", At: base, seen: base},
+			want: event{Name: "a", At: base, seen: base.Add(time.Second)},
+			opt:  EquateApproxTime(time.Millisecond),
+		},
+		"nested other field": {
+			got:  event{Name: "a", At: base},
+			want: event{Name: "b", At: base},
+			opt:  EquateApproxTime(time.Millisecond),
+		},
+		"slice": {
+			got:   []time.Time{base, base},
+			want:  []time.Time{base.Add(time.Nanosecond), base},
+			opt:   EquateApproxTime(time.Microsecond),
+			equal: true,
+		},
+		"map": {
+			got:   map[string]*event{"a": {At: base}},
+			want:  map[string]*event{"a": {At: base.Add(time.Nanosecond)}},
+			opt:   EquateApproxTime(time.Microsecond),
+			equal: true,
+		},
+		"monotonic": {
+			got:   time.Now(),
+			want:  time.Now().Round(0),
+			opt:   EquateApproxTime(time.Second),
+			equal: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			Equal(tb, tc.got, tc.want, tc.opt)
+			if tb.failed == tc.equal {
+				t.Errorf("got: failed=%v; want: failed=%v; %s", tb.failed, !tc.equal, tb.msg)
+			}
+		})
+	}
+
+	t.Run("typed", func(t *testing.T) {
+		tb := &mockTB{}
+		Equal(tb, base, base.Add(time.Millisecond), EquateApproxTime(time.Second))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
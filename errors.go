@@ -0,0 +1,314 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrorsEqual asserts that got and want hold matching errors in the same
+// order. Errors match if got wraps want according to [errors.Is], or if their
+// messages are identical.
+func ErrorsEqual(t TestingT, got, want []error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(got) != len(want) {
+		t.Fatalf("got: %s; want: %s; length %d != %d;%s",
+			formatErrors(got), formatErrors(want), len(got), len(want), cfg.msg())
+		return
+	}
+
+	for i := range got {
+		if !errorsMatch(got[i], want[i]) {
+			t.Fatalf("got: %s; want: %s; mismatch at index %d;%s%s",
+				formatErrors(got), formatErrors(want), i, cfg.msg(), errorDetail(got[i]))
+			return
+		}
+	}
+}
+
+// ErrorMapsEqual asserts that got and want have the same keys, holding
+// matching errors as defined by [ErrorsEqual].
+func ErrorMapsEqual[K comparable](t TestingT, got, want map[K]error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var problems []string
+	var details strings.Builder
+	for _, k := range sortedKeys(want) {
+		g, ok := got[k]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("missing key %#v", k))
+		case !errorsMatch(g, want[k]):
+			problems = append(problems, fmt.Sprintf("key %#v: got: %s; want: %s",
+				k, formatError(g), formatError(want[k])))
+			if detail := errorDetail(g); detail != "" {
+				fmt.Fprintf(&details, "\n  key %#v: %s%s", k, formatError(g), strings.ReplaceAll(detail, "\n", "\n  "))
+			}
+		}
+	}
+	for _, k := range sortedKeys(got) {
+		if _, ok := want[k]; !ok {
+			problems = append(problems, fmt.Sprintf("unexpected key %#v", k))
+		}
+	}
+
+	if len(problems) > 0 {
+		t.Fatalf("error maps differ: %s;%s%s", strings.Join(problems, "; "), cfg.msg(), details.String())
+	}
+}
+
+// NoError asserts that err is nil. On failure the error is reported with its
+// type, followed by the tree of errors it wraps.
+func NoError(t TestingT, err error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if err != nil {
+		t.Fatalf("got: %s; want: no error;%s%s", formatError(err), cfg.msg(), errorDetail(err))
+	}
+}
+
+// SomeError asserts that err is not nil.
+func SomeError(t TestingT, err error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if err == nil {
+		t.Fatalf("got: <nil>; want: error;%s", cfg.msg())
+	}
+}
+
+// ErrorContains asserts that err is not nil and that its message contains
+// every one of substrings, listing those that are missing on failure:
+//
+//	assert.ErrorContains(t, err, []string{"config.yaml", "permission denied"})
+func ErrorContains(t TestingT, err error, substrings []string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if err == nil {
+		t.Fatalf("got: <nil>; want: error containing all of %q;%s", substrings, cfg.msg())
+		return
+	}
+
+	msg := err.Error()
+	var missing []string
+	for _, sub := range substrings {
+		if !strings.Contains(msg, sub) {
+			missing = append(missing, sub)
+		}
+	}
+	if len(missing) > 0 {
+		t.Fatalf("got: %s; want: error containing all of %q; missing: %q;%s%s",
+			formatError(err), substrings, missing, cfg.msg(), errorDetail(err))
+	}
+}
+
+// ErrorMatchesRegexp asserts that err is not nil and that its message
+// matches pattern.
+func ErrorMatchesRegexp(t TestingT, err error, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	re, perr := regexp.Compile(pattern)
+	if perr != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, perr.Error())
+		return
+	}
+	if failure := errorMismatch(err, re); failure != "" {
+		t.Fatalf("%s%s%s", failure, cfg.msg(), errorDetail(err))
+	}
+}
+
+// ErrorAsType asserts that err has an error of type E in its chain, as by
+// [errors.As], and returns it for further assertions on its fields. It
+// returns the zero E on failure.
+func ErrorAsType[E error](t TestingT, err error, opts ...any) E {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var target E
+	if !errors.As(err, &target) {
+		t.Fatalf("got: %s; want: %v;%s%s", formatError(err), reflect.TypeFor[E](), cfg.msg(), errorDetail(err))
+	}
+	return target
+}
+
+// ErrorIsAny asserts that err matches at least one of targets according to
+// [errors.Is], for cases where several errors are acceptable, such as
+// platform dependent ones:
+//
+//	assert.ErrorIsAny(t, err, []error{syscall.ECONNRESET, syscall.EPIPE}, "write")
+func ErrorIsAny(t TestingT, err error, targets []error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(targets) == 0 {
+		t.Fatalf("no targets to match;%s", cfg.msg())
+		return
+	}
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return
+		}
+	}
+	t.Fatalf("got: %s; want: any of %s;%s%s", formatError(err), formatErrors(targets), cfg.msg(), errorDetail(err))
+}
+
+// ErrorIsAll asserts that err matches every one of targets according to
+// [errors.Is], as when checking that each expected error was joined into err
+// with [errors.Join]:
+//
+//	assert.ErrorIsAll(t, v.Validate(), []error{ErrNoName, ErrNoEmail})
+func ErrorIsAll(t TestingT, err error, targets []error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(targets) == 0 {
+		t.Fatalf("no targets to match;%s", cfg.msg())
+		return
+	}
+	var missing []error
+	for _, target := range targets {
+		if !errors.Is(err, target) {
+			missing = append(missing, target)
+		}
+	}
+	if len(missing) > 0 {
+		t.Fatalf("got: %s; want: all of %s; missing: %s;%s%s",
+			formatError(err), formatErrors(targets), formatErrors(missing), cfg.msg(), errorDetail(err))
+	}
+}
+
+// ErrorExactly asserts that got is want itself, without unwrapping, for
+// cases where [errors.Is] is too permissive, such as checking that a
+// sentinel error is returned unwrapped.
+func ErrorExactly(t TestingT, got, want error, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if sameError(got, want) {
+		return
+	}
+	var note string
+	if want != nil && errors.Is(got, want) {
+		note = " got wraps want;"
+	}
+	t.Fatalf("got: %s; want: exactly %s;%s%s", formatError(got), formatError(want), note, cfg.msg())
+}
+
+// sameError reports whether got and want are the same error value. Errors of
+// an incomparable type, for which == would panic, are never the same.
+func sameError(got, want error) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+	if reflect.TypeOf(got) != reflect.TypeOf(want) || !reflect.TypeOf(got).Comparable() {
+		return false
+	}
+	return got == want
+}
+
+func errorsMatch(got, want error) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+	return errors.Is(got, want) || got.Error() == want.Error()
+}
+
+// formatError renders an error as its type and message. Errors joined with
+// [errors.Join] are rendered as a count, as their message spans several
+// lines; see errorDetail.
+func formatError(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	if errs, ok := joinedErrors(err); ok {
+		return fmt.Sprintf("%d joined errors", len(errs))
+	}
+	return fmt.Sprintf("%T(%v)", err, err)
+}
+
+// joinedErrors returns the errors joined in err, and reports whether err was
+// made by [errors.Join] or an equivalent, with the messages of its errors on
+// separate lines.
+func joinedErrors(err error) ([]error, bool) {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil, false
+	}
+	errs := joined.Unwrap()
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if e != nil {
+			msgs = append(msgs, e.Error())
+		}
+	}
+	return errs, err.Error() == strings.Join(msgs, "\n")
+}
+
+// errorDetail renders the lines following a failure line about err: the
+// errors it wraps, one per line and indented by depth, or "" if err wraps
+// none. A chain of wrapped errors is rendered as a tree with a single branch.
+func errorDetail(err error) string {
+	var b strings.Builder
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		for _, e := range unwrapErrors(err) {
+			fmt.Fprintf(&b, "\n%s%s", strings.Repeat("  ", depth), formatError(e))
+			walk(e, depth+1)
+		}
+	}
+	walk(err, 1)
+	return b.String()
+}
+
+// unwrapErrors returns the non-nil errors directly wrapped by err.
+func unwrapErrors(err error) []error {
+	var errs []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		errs = []error{e.Unwrap()}
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	}
+	return slices.DeleteFunc(errs, func(e error) bool { return e == nil })
+}
+
+func formatErrors(errs []error) string {
+	parts := make([]string, len(errs))
+	for i, err := range errs {
+		parts[i] = formatError(err)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+// sliceErr is an error of an incomparable type.
+type sliceErr []string
+
+func (e sliceErr) Error() string { return strings.Join(e, ", ") }
+
+func TestErrorsEqual(t *testing.T) {
+	errOops := errors.New("oops")
+
+	testCases := map[string]struct {
+		got  []error
+		want []error
+		msg  string
+	}{
+		"nil slices": {},
+		"same errors": {
+			got:  []error{errOops, nil},
+			want: []error{errOops, nil},
+		},
+		"wrapped": {
+			got:  []error{fmt.Errorf("wrapped: %w", errOops)},
+			want: []error{errOops},
+		},
+		"same message": {
+			got:  []error{errors.New("oops")},
+			want: []error{errors.New("oops")},
+		},
+		"different length": {
+			got:  []error{errOops},
+			want: []error{errOops, errOops},
+			msg:  "got: [*errors.errorString(oops)]; want: [*errors.errorString(oops), *errors.errorString(oops)]; length 1 != 2;",
+		},
+		"different error": {
+			got:  []error{errOops, errors.New("one")},
+			want: []error{errOops, errors.New("two")},
+			msg:  "got: [*errors.errorString(oops), *errors.errorString(one)]; want: [*errors.errorString(oops), *errors.errorString(two)]; mismatch at index 1;",
+		},
+		"wrapped mismatch": {
+			got:  []error{fmt.Errorf("load: %w", errors.New("one"))},
+			want: []error{errOops},
+			msg:  "got: [*fmt.wrapError(load: one)]; want: [*errors.errorString(oops)]; mismatch at index 0;\n  *errors.errorString(one)",
+		},
+		"nil vs error": {
+			got:  []error{nil},
+			want: []error{errOops},
+			msg:  "got: [<nil>]; want: [*errors.errorString(oops)]; mismatch at index 0;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			ErrorsEqual(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestErrorMapsEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tb := &mockTB{}
+		ErrorMapsEqual(tb,
+			map[string]error{"a": errors.New("oops"), "b": nil},
+			map[string]error{"a": errors.New("oops"), "b": nil},
+		)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("different", func(t *testing.T) {
+		tb := &mockTB{}
+		ErrorMapsEqual(tb,
+			map[int]error{1: fmt.Errorf("load: %w", errors.New("one")), 3: nil, 10: nil},
+			map[int]error{1: errors.New("uno"), 2: nil, 10: nil},
+		)
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "error maps differ: key 1: got: *fmt.wrapError(load: one); want: *errors.errorString(uno); missing key 2; unexpected key 3;\n" +
+			"  key 1: *fmt.wrapError(load: one)\n" +
+			"    *errors.errorString(one)"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestNoError(t *testing.T) {
+	errOops := errors.New("oops")
+	errReset, errPipe := errors.New("connection reset"), errors.New("broken pipe")
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"no error": {check: func(tb TestingT) { NoError(tb, nil) }},
+		"error": {
+			check: func(tb TestingT) { NoError(tb, errOops, "setup") },
+			msg:   "got: *errors.errorString(oops); want: no error; setup",
+		},
+		"wrapped": {
+			check: func(tb TestingT) { NoError(tb, fmt.Errorf("load: %w", errOops)) },
+			msg:   "got: *fmt.wrapError(load: oops); want: no error;\n  *errors.errorString(oops)",
+		},
+		"joined": {
+			check: func(tb TestingT) { NoError(tb, errors.Join(errOops, errType("bad"))) },
+			msg:   "got: 2 joined errors; want: no error;\n  *errors.errorString(oops)\n  assert.errType(bad)",
+		},
+		"some error": {check: func(tb TestingT) { SomeError(tb, errOops) }},
+		"no error for some": {
+			check: func(tb TestingT) { SomeError(tb, nil, "validate") },
+			msg:   "got: <nil>; want: error; validate",
+		},
+		"contains all": {
+			check: func(tb TestingT) {
+				ErrorContains(tb, fmt.Errorf("open config.yaml: %w", errOops), []string{"config.yaml", "oops"})
+			},
+		},
+		"contains some": {
+			check: func(tb TestingT) {
+				ErrorContains(tb, fmt.Errorf("open config.yaml: %w", errOops), []string{"config.yaml", "denied", "open", "path"})
+			},
+			msg: `got: *fmt.wrapError(open config.yaml: oops); want: error containing all of ["config.yaml" "denied" "open" "path"]; missing: ["denied" "path"];` + "\n  *errors.errorString(oops)",
+		},
+		"contains nil": {
+			check: func(tb TestingT) { ErrorContains(tb, nil, []string{"oops"}) },
+			msg:   `got: <nil>; want: error containing all of ["oops"];`,
+		},
+		"is any": {
+			check: func(tb TestingT) {
+				ErrorIsAny(tb, fmt.Errorf("write: %w", errPipe), []error{errReset, errPipe})
+			},
+		},
+		"is none": {
+			check: func(tb TestingT) { ErrorIsAny(tb, errOops, []error{errReset, errPipe}, "write") },
+			msg:   "got: *errors.errorString(oops); want: any of [*errors.errorString(connection reset), *errors.errorString(broken pipe)]; write",
+		},
+		"is any nil": {
+			check: func(tb TestingT) { ErrorIsAny(tb, nil, []error{errOops}) },
+			msg:   "got: <nil>; want: any of [*errors.errorString(oops)];",
+		},
+		"is any no targets": {
+			check: func(tb TestingT) { ErrorIsAny(tb, errOops, nil) },
+			msg:   "no targets to match;",
+		},
+		"is all": {
+			check: func(tb TestingT) {
+				ErrorIsAll(tb, errors.Join(errPipe, fmt.Errorf("x: %w", errReset)), []error{errReset, errPipe})
+			},
+		},
+		"is not all": {
+			check: func(tb TestingT) {
+				ErrorIsAll(tb, errors.Join(errPipe, errors.Join(errType("bad"), errOops)), []error{errReset, errPipe, errOops}, "close")
+			},
+			msg: "got: 2 joined errors; want: all of [*errors.errorString(connection reset), *errors.errorString(broken pipe), *errors.errorString(oops)]; missing: [*errors.errorString(connection reset)]; close\n" +
+				"  *errors.errorString(broken pipe)\n" +
+				"  2 joined errors\n" +
+				"    assert.errType(bad)\n" +
+				"    *errors.errorString(oops)",
+		},
+		"is all no targets": {
+			check: func(tb TestingT) { ErrorIsAll(tb, errOops, nil) },
+			msg:   "no targets to match;",
+		},
+		"joined for error": {
+			check: func(tb TestingT) { Error(tb, errors.Join(errPipe, errType("bad")), errReset) },
+			msg:   "got: 2 joined errors; want: *errors.errorString(connection reset);\n  *errors.errorString(broken pipe)\n  assert.errType(bad)",
+		},
+		"joined unexpected": {
+			check: func(tb TestingT) { Error(tb, errors.Join(errPipe, errOops), nil) },
+			msg:   "unexpected error: 2 joined errors;\n  *errors.errorString(broken pipe)\n  *errors.errorString(oops)",
+		},
+		"wrapping several": {
+			check: func(tb TestingT) { Error(tb, fmt.Errorf("%w: %w", errPipe, errOops), errReset) },
+			msg:   "got: *fmt.wrapErrors(broken pipe: oops); want: *errors.errorString(connection reset);\n  *errors.errorString(broken pipe)\n  *errors.errorString(oops)",
+		},
+		"unwrap chain": {
+			check: func(tb TestingT) {
+				err := fmt.Errorf("load: %w", &fs.PathError{Op: "open", Path: "a.yaml", Err: errType("denied")})
+				Error(tb, err, fs.ErrNotExist, "config")
+			},
+			msg: "got: *fmt.wrapError(load: open a.yaml: denied); want: *errors.errorString(file does not exist); config\n" +
+				"  *fs.PathError(open a.yaml: denied)\n" +
+				"    assert.errType(denied)",
+		},
+		"exactly":     {check: func(tb TestingT) { ErrorExactly(tb, errOops, errOops) }},
+		"exactly nil": {check: func(tb TestingT) { ErrorExactly(tb, nil, nil) }},
+		"exactly wrapped": {
+			check: func(tb TestingT) { ErrorExactly(tb, fmt.Errorf("read: %w", errOops), errOops, "boundary") },
+			msg:   "got: *fmt.wrapError(read: oops); want: exactly *errors.errorString(oops); got wraps want; boundary",
+		},
+		"exactly same message": {
+			check: func(tb TestingT) { ErrorExactly(tb, errors.New("oops"), errOops) },
+			msg:   "got: *errors.errorString(oops); want: exactly *errors.errorString(oops);",
+		},
+		"exactly nil got": {
+			check: func(tb TestingT) { ErrorExactly(tb, nil, errOops) },
+			msg:   "got: <nil>; want: exactly *errors.errorString(oops);",
+		},
+		"exactly incomparable": {
+			check: func(tb TestingT) { ErrorExactly(tb, sliceErr{"a"}, sliceErr{"a"}) },
+			msg:   "got: assert.sliceErr(a); want: exactly assert.sliceErr(a);",
+		},
+		"matches regexp": {
+			check: func(tb TestingT) { ErrorMatchesRegexp(tb, fmt.Errorf("dial tcp: %w", errOops), `^dial \w+:`) },
+		},
+		"does not match regexp": {
+			check: func(tb TestingT) { ErrorMatchesRegexp(tb, errOops, `^dial`, "connect") },
+			msg:   `got: "oops"; want to match "^dial"; connect`,
+		},
+		"wrapped does not match regexp": {
+			check: func(tb TestingT) { ErrorMatchesRegexp(tb, fmt.Errorf("load: %w", errOops), `^dial`) },
+			msg:   "got: \"load: oops\"; want to match \"^dial\";\n  *errors.errorString(oops)",
+		},
+		"nil for regexp": {
+			check: func(tb TestingT) { ErrorMatchesRegexp(tb, nil, `^dial`) },
+			msg:   `got: <nil>; want: error matching "^dial";`,
+		},
+		"bad regexp": {
+			check: func(tb TestingT) { ErrorMatchesRegexp(tb, errOops, `(`) },
+			msg:   "unable to parse regexp pattern (: error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestErrorAsType(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist}
+
+	t.Run("in chain", func(t *testing.T) {
+		tb := &mockTB{}
+		got := ErrorAsType[*fs.PathError](tb, fmt.Errorf("load: %w", pathErr))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if got != pathErr {
+			t.Errorf("got: %v; want: %v;", got, pathErr)
+		}
+	})
+
+	testCases := map[string]struct {
+		err error
+		msg string
+	}{
+		"not in chain": {
+			err: fmt.Errorf("load: %w", errType("bad")),
+			msg: "got: *fmt.wrapError(load: bad); want: *fs.PathError; load\n  assert.errType(bad)",
+		},
+		"nil": {
+			err: nil,
+			msg: "got: <nil>; want: *fs.PathError; load",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			got := ErrorAsType[*fs.PathError](tb, tc.err, "load")
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+			if got != nil {
+				t.Errorf("got: %v; want: <nil>;", got)
+			}
+		})
+	}
+}
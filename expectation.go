@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+// Expected is a reusable expectation built by [Expectation].
+type Expected[T any] struct {
+	want T
+	cfg  *config
+}
+
+// Expectation returns an expectation that got equals want, as by [Equal].
+// The options are processed once, so an expectation with an expensive set of
+// options can be checked against many values cheaply. Arguments of
+// unsupported types are reported by each Check:
+//
+//	exp := assert.Expectation(want, assert.EquateNaNs(), "decoded")
+//	for _, got := range results {
+//		exp.Check(t, got)
+//	}
+func Expectation[T any](want T, opts ...any) *Expected[T] {
+	return &Expected[T]{want: want, cfg: parseConfig(opts...)}
+}
+
+// Check asserts that got equals the expected value, as by [Equal].
+func (e *Expected[T]) Check(t TestingT, got T) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	countUsage(1)
+	e.cfg.reportUnsupported(t)
+
+	if failure := equalMismatch(got, e.want, e.cfg); failure != "" {
+		t.Fatalf("%s", failure)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpectation(t *testing.T) {
+	nan := math.NaN()
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal": {check: func(tb TestingT) { Expectation(3).Check(tb, 3) }},
+		"not equal": {
+			check: func(tb TestingT) { Expectation(3, "count").Check(tb, 4) },
+			msg:   "got: 4; want: 3; count",
+		},
+		"options": {check: func(tb TestingT) { Expectation([]float64{1, nan}, EquateNaNs()).Check(tb, []float64{1, nan}) }},
+		"formatted message": {
+			check: func(tb TestingT) { Expectation("a", Msgf("case %d", 2)).Check(tb, "b") },
+			msg:   `got: "b"; want: "a"; case 2`,
+		},
+		"multi-line": {
+			check: func(tb TestingT) { Expectation("a\nb").Check(tb, "a\nc") },
+			msg:   "got and want differ;\n--- got\n+++ want\n  a\n- c\n+ b",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+
+	t.Run("unsupported argument", func(t *testing.T) {
+		exp := Expectation(1, 2)
+		tb := &mockTB{}
+		exp.Check(tb, 1)
+		wantMsg := "unsupported argument of type int; want an Option or a string message; use Msgf for formatted messages;"
+		if tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: %q (fatal %v); want: %q;", tb.msg, tb.fatal, wantMsg)
+		}
+	})
+
+	t.Run("reused", func(t *testing.T) {
+		exp := Expectation(map[string]int{"a": 1}, "lookup")
+		for _, got := range []map[string]int{{"a": 1}, {"a": 2}, {"a": 1}} {
+			tb := &mockTB{}
+			exp.Check(tb, got)
+			if tb.failed != (got["a"] != 1) {
+				t.Errorf("got: failed %v for %v; msg: %q;", tb.failed, got, tb.msg)
+			}
+		}
+	})
+}
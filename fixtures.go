@@ -0,0 +1,222 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// GoldenUpdateEnv names the environment variable that, when set to a
+// non-empty value, makes [GoldenCorpus] rewrite golden files instead of
+// comparing against them. A boolean -update flag defined by the test binary
+// has the same effect.
+const GoldenUpdateEnv = "ASSERT_UPDATE_GOLDEN"
+
+// goldenSuffix is appended to the name of an input file to name its golden
+// file.
+const goldenSuffix = ".golden"
+
+// LoadBytes returns the contents of the fixture file at path, failing the
+// test if it cannot be read.
+func LoadBytes(t TestingT, path string, opts ...any) []byte {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to load fixture: %s;%s", err, cfg.msg())
+		return nil
+	}
+	return data
+}
+
+// LoadJSON decodes the JSON fixture file at path into a T, failing the test
+// if it cannot be read or decoded.
+//
+// There is no YAML counterpart, since this module depends only on the
+// standard library. Decode the result of [LoadBytes] with a YAML package
+// instead.
+func LoadJSON[T any](t TestingT, path string, opts ...any) T {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var v T
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to load fixture: %s;%s", err, cfg.msg())
+		return v
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("unable to decode fixture %s%s as %T: %s;%s",
+			path, jsonErrorPosition(data, err), v, err, cfg.msg())
+	}
+	return v
+}
+
+// jsonErrorPosition renders the line and column of a JSON decoding error, if
+// the error carries an offset.
+func jsonErrorPosition(data []byte, err error) string {
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return ""
+	}
+
+	offset = min(offset, int64(len(data)))
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	col := offset - int64(bytes.LastIndexByte(data[:offset], '\n'))
+	return fmt.Sprintf(":%d:%d", line, col)
+}
+
+// GoldenCorpus runs transform on every file in dir, and compares each output
+// with the golden file next to its input, named after the input with a
+// ".golden" suffix. Subdirectories are ignored.
+//
+// If t can run subtests, as [testing.T] does or through a Run method taking
+// a callback of another TestingT type, each input gets its own subtest. When
+// updating is enabled through [GoldenUpdateEnv] or an -update flag, golden
+// files are written instead.
+func GoldenCorpus(t TestingT, dir string, transform func(in []byte) []byte, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unable to read corpus: %s;%s", err, cfg.msg())
+		return
+	}
+
+	update := updateGolden()
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || strings.HasSuffix(name, goldenSuffix) {
+			continue
+		}
+		path := filepath.Join(dir, name)
+
+		ran := runSubtest(t, name, func(t TestingT) {
+			if ht, ok := t.(helperT); ok {
+				ht.Helper()
+			}
+			checkGolden(t, "", path, transform, update, cfg)
+		})
+		if !ran {
+			checkGolden(t, name+": ", path, transform, update, cfg)
+		}
+	}
+}
+
+// GeneratedFileUpToDate asserts that the committed file at path matches the
+// output of generate, such as the go generate output of a code generator run
+// into memory. On mismatch the failure shows a diff and the command that
+// rewrites the file: in update mode, as for [GoldenCorpus], the file is
+// written instead of compared.
+func GeneratedFileUpToDate(t TestingT, path string, generate func() ([]byte, error), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	want, err := generate()
+	if err != nil {
+		t.Fatalf("unable to generate %s: %s;%s", path, err, cfg.msg())
+		return
+	}
+
+	if updateGolden() {
+		if err := os.WriteFile(path, want, 0o644); err != nil {
+			t.Fatalf("unable to update generated file: %s;%s", err, cfg.msg())
+		}
+		return
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read generated file: %s; run %s to create it;%s", err, regenerateCommand(t), cfg.msg())
+		return
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s is out of date; run %s to regenerate it; %s",
+			path, regenerateCommand(t), textMismatch(string(got), string(want), cfg))
+	}
+}
+
+// regenerateCommand returns the command that reruns the test run by t in
+// update mode.
+func regenerateCommand(t TestingT) string {
+	cmd := GoldenUpdateEnv + "=1 go test"
+	if name := testName(t); name != "" {
+		parts := strings.Split(name, "/")
+		for i, p := range parts {
+			parts[i] = "^" + regexp.QuoteMeta(p) + "$"
+		}
+		cmd += " -run '" + strings.Join(parts, "/") + "'"
+	}
+	return cmd + " ."
+}
+
+// updateGolden reports whether golden files should be rewritten.
+func updateGolden() bool {
+	if os.Getenv(GoldenUpdateEnv) != "" {
+		return true
+	}
+	f := flag.Lookup("update")
+	return f != nil && f.Value.String() == "true"
+}
+
+// checkGolden compares the output of transform for the input at path with
+// its golden file, or rewrites the golden file if update is set. Failures are
+// reported with Errorf, prefixed by prefix, so that the remaining inputs are
+// still checked.
+func checkGolden(t TestingT, prefix, path string, transform func([]byte) []byte, update bool, cfg *config) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	in, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("%sunable to read input: %s;%s", prefix, err, cfg.msg())
+		return
+	}
+	got := transform(in)
+
+	golden := path + goldenSuffix
+	if update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Errorf("%sunable to update golden file: %s;%s", prefix, err, cfg.msg())
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Errorf("%sunable to read golden file: %s; set %s=1 to create it;%s", prefix, err, GoldenUpdateEnv, cfg.msg())
+		return
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s%s", prefix, textMismatch(string(got), string(want), cfg))
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// user matches testdata/user.json.
+type user struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestLoadBytes(t *testing.T) {
+	t.Run("exists", func(t *testing.T) {
+		tb := &mockTB{}
+		data := LoadBytes(tb, "testdata/user.json")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if !strings.Contains(string(data), "gopher") {
+			t.Errorf("got: %q; want fixture contents", data)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		tb := &mockTB{}
+		LoadBytes(tb, "testdata/missing.json")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "unable to load fixture: open testdata/missing.json: no such file or directory;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestLoadJSON(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tb := &mockTB{}
+		got := LoadJSON[user](tb, "testdata/user.json")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		want := user{Name: "gopher", Age: 15}
+		if got != want {
+			t.Errorf("got: %#v; want: %#v;", got, want)
+		}
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		tb := &mockTB{}
+		LoadJSON[user](tb, "testdata/invalid.json")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantPrefix := "unable to decode fixture testdata/invalid.json:3:19 as assert.user: "
+		if !strings.HasPrefix(tb.msg, wantPrefix) {
+			t.Errorf("got: %q; want prefix: %q;", tb.msg, wantPrefix)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		tb := &mockTB{}
+		LoadJSON[user](tb, "testdata/missing.json")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+	})
+}
+
+func TestGoldenCorpus(t *testing.T) {
+	writeCorpus := func(t *testing.T, files map[string]string) string {
+		dir := t.TempDir()
+		for name, content := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dir
+	}
+	upper := func(in []byte) []byte { return bytes.ToUpper(in) }
+
+	t.Run("subtests", func(t *testing.T) {
+		dir := writeCorpus(t, map[string]string{
+			"a.txt": "abc", "a.txt.golden": "ABC",
+			"b.txt": "x\ny", "b.txt.golden": "X\nY",
+		})
+		if err := os.Mkdir(filepath.Join(dir, "nested"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		GoldenCorpus(t, dir, upper)
+	})
+
+	t.Run("mismatches", func(t *testing.T) {
+		dir := writeCorpus(t, map[string]string{
+			"a.txt": "abc", "a.txt.golden": "ABD",
+			"b.txt": "ok", "b.txt.golden": "OK",
+			"c.txt": "new",
+		})
+		rec := &RecordingT{}
+		GoldenCorpus(rec, dir, upper)
+		var msgs []string
+		for _, f := range rec.Failures() {
+			msgs = append(msgs, f.Message)
+		}
+		wantMsgs := []string{
+			`a.txt: got: "ABC"; want: "ABD";`,
+			"c.txt: unable to read golden file: open " + filepath.Join(dir, "c.txt.golden") +
+				": no such file or directory; set ASSERT_UPDATE_GOLDEN=1 to create it;",
+		}
+		if !slices.Equal(msgs, wantMsgs) {
+			t.Errorf("got: %q; want: %q;", msgs, wantMsgs)
+		}
+		if FailedFatally(rec) {
+			t.Error("should not be fatal")
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Setenv(GoldenUpdateEnv, "1")
+		dir := writeCorpus(t, map[string]string{"a.txt": "abc", "a.txt.golden": "stale"})
+		rec := &RecordingT{}
+		GoldenCorpus(rec, dir, upper)
+		if Failed(rec) {
+			t.Fatalf("failed: %v", rec.Failures())
+		}
+		got, err := os.ReadFile(filepath.Join(dir, "a.txt.golden"))
+		if err != nil || string(got) != "ABC" {
+			t.Errorf("got: %q (%v); want: %q;", got, err, "ABC")
+		}
+	})
+
+	t.Run("missing corpus", func(t *testing.T) {
+		tb := &mockTB{}
+		GoldenCorpus(tb, filepath.Join(t.TempDir(), "missing"), upper)
+		if !tb.fatal || !strings.HasPrefix(tb.msg, "unable to read corpus: ") {
+			t.Errorf("got: fatal=%v %q; want fatal read error", tb.fatal, tb.msg)
+		}
+	})
+}
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	generate := func() ([]byte, error) { return []byte("package gen\n\nconst X = 1\n"), nil }
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "gen.go")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("up to date", func(t *testing.T) {
+		tb := &mockTB{}
+		GeneratedFileUpToDate(tb, writeFile(t, "package gen\n\nconst X = 1\n"), generate)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("out of date", func(t *testing.T) {
+		path := writeFile(t, "package gen\n\nconst X = 0\n")
+		rec := NewRecordingT(t)
+		GeneratedFileUpToDate(rec, path, generate)
+		f := rec.Failures()
+		wantPrefix := path + " is out of date; run ASSERT_UPDATE_GOLDEN=1 go test " +
+			`-run '^TestGeneratedFileUpToDate$/^out_of_date$' . to regenerate it; got and want differ;` + "\n"
+		if len(f) != 1 || f[0].Severity != SeverityFatal || !strings.HasPrefix(f[0].Message, wantPrefix) ||
+			!strings.Contains(f[0].Message, "const X = 0") {
+			t.Errorf("got: %v; want: fatal %q...;", f, wantPrefix)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "gen.go")
+		rec := &RecordingT{}
+		GeneratedFileUpToDate(rec, path, generate)
+		wantMsg := "unable to read generated file: open " + path +
+			": no such file or directory; run ASSERT_UPDATE_GOLDEN=1 go test . to create it;"
+		if f := rec.Failures(); len(f) != 1 || f[0].Severity != SeverityFatal || f[0].Message != wantMsg {
+			t.Errorf("got: %v; want: fatal %q;", f, wantMsg)
+		}
+	})
+
+	t.Run("generate error", func(t *testing.T) {
+		tb := &mockTB{}
+		GeneratedFileUpToDate(tb, "gen.go", func() ([]byte, error) { return nil, errors.New("boom") })
+		if wantMsg := "unable to generate gen.go: boom;"; !tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Setenv(GoldenUpdateEnv, "1")
+		path := writeFile(t, "stale")
+		tb := &mockTB{}
+		GeneratedFileUpToDate(tb, path, generate)
+		if tb.failed {
+			t.Fatalf("failed: %s", tb.msg)
+		}
+		got, err := os.ReadFile(path)
+		if want, _ := generate(); err != nil || !bytes.Equal(got, want) {
+			t.Errorf("got: %q (%v); want: %q;", got, err, want)
+		}
+	})
+}
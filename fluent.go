@@ -0,0 +1,134 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+// Subject is a fluent handle on a value under test, for writing assertions
+// as chains:
+//
+//	assert.That(t, got).Equals(want).And().HasLen(3)
+//
+// Each method runs the package level assertion of the same meaning, and
+// returns the Subject so further assertions can be chained. If t has no
+// FailNow method, the assertions after a fatal failure are skipped.
+type Subject[T any] struct {
+	t       TestingT
+	got     T
+	tracker *fatalTracker
+}
+
+// That returns a [Subject] for got, reporting failures to t.
+func That[T any](t TestingT, got T) *Subject[T] {
+	s := &Subject[T]{t: t, got: got}
+	if _, ok := t.(failNowT); !ok {
+		s.tracker = &fatalTracker{TestingT: t}
+		s.t = s.tracker
+	}
+	return s
+}
+
+// stopped reports whether an earlier assertion in the chain failed fatally
+// without stopping the test.
+func (s *Subject[T]) stopped() bool {
+	return s.tracker != nil && s.tracker.fatal
+}
+
+// And returns s unchanged, to make chains read naturally.
+func (s *Subject[T]) And() *Subject[T] {
+	return s
+}
+
+// Equals is the fluent form of [Equal].
+func (s *Subject[T]) Equals(want T, opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	Equal(s.t, s.got, want, opts...)
+	return s
+}
+
+// NotEquals is the fluent form of [NotEqual].
+func (s *Subject[T]) NotEquals(want T, opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	NotEqual(s.t, s.got, want, opts...)
+	return s
+}
+
+// IsNil is the fluent form of [Nil].
+func (s *Subject[T]) IsNil(opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	Nil(s.t, s.got, opts...)
+	return s
+}
+
+// IsNotNil is the fluent form of [NotNil].
+func (s *Subject[T]) IsNotNil(opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	NotNil(s.t, s.got, opts...)
+	return s
+}
+
+// HasLen is the fluent form of [Len].
+func (s *Subject[T]) HasLen(want int, opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	Len(s.t, s.got, want, opts...)
+	return s
+}
+
+// Satisfies asserts that pred returns true for the value under test.
+func (s *Subject[T]) Satisfies(pred func(T) bool, opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	cfg := newConfig(s.t, opts...)
+
+	if !pred(s.got) {
+		s.t.Fatalf("got: %s; does not satisfy predicate;%s", cfg.format(s.got), cfg.msg())
+	}
+	return s
+}
+
+// Passes asserts that the value under test passes c, given args, such as a
+// checker written for quicktest. On failure the value, the arguments and any
+// notes from c are reported under the names given by c.
+func (s *Subject[T]) Passes(c Checker, args []any, opts ...any) *Subject[T] {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+	if s.stopped() {
+		return s
+	}
+	cfg := newConfig(s.t, opts...)
+
+	if failure := checkerFailure(c, s.got, args, cfg); failure != "" {
+		s.t.Fatalf("%s%s", failure, cfg.msg())
+	}
+	return s
+}
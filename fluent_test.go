@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+func TestThat(t *testing.T) {
+	t.Run("passing chain", func(t *testing.T) {
+		tb := &mockTB{}
+		That(tb, []int{1, 2, 3}).
+			Equals([]int{1, 2, 3}).
+			And().HasLen(3).
+			And().NotEquals([]int{3, 2, 1}).
+			And().IsNotNil().
+			And().Satisfies(func(s []int) bool { return s[0] == 1 })
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		tb := &mockTB{}
+		That(tb, (*int)(nil)).IsNil()
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equals": {
+			check: func(tb TestingT) { That(tb, 42).Equals(84) },
+			msg:   "got: 42; want: 84;",
+		},
+		"not equals": {
+			check: func(tb TestingT) { That(tb, 42).NotEquals(42) },
+			msg:   "got: 42; expected values to be different;",
+		},
+		"has len": {
+			check: func(tb TestingT) { That(tb, "abc").Equals("abc").And().HasLen(2) },
+			msg:   `got: len 3 ("abc"); want: len 2;`,
+		},
+		"satisfies": {
+			check: func(tb TestingT) { That(tb, 3).Satisfies(func(n int) bool { return n%2 == 0 }, "even") },
+			msg:   "got: 3; does not satisfy predicate; even",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestThatWithoutFailNow(t *testing.T) {
+	rec := &RecordingT{}
+	That(rec, 42).Equals(84).And().HasLen(1).And().Equals(42)
+	failures := rec.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("got: %d failures; want: 1;", len(failures))
+	}
+	if want := "got: 42; want: 84;"; failures[0].Message != want {
+		t.Errorf("got: %q; want: %q;", failures[0].Message, want)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FloatFormat sets how floating point values are rendered in failure
+// messages, using the verb and precision of [strconv.FormatFloat]. For
+// example FloatFormat('f', 2) renders 0.30000000000000004 as 0.30, and
+// FloatFormat('e', 3) renders 12345.678 as 1.235e+04.
+//
+// By default floats are rendered with the fewest digits that represent them
+// exactly, so tiny differences are always visible.
+func FloatFormat(verb byte, prec int) Option {
+	return optionFunc(func(c *config) {
+		c.floatVerb = verb
+		c.floatPrec = prec
+	})
+}
+
+// format renders v for a failure message.
+func (c *config) format(v any) string {
+	if s, ok := formatBig(v); ok {
+		return s
+	}
+
+	rv := reflect.ValueOf(v)
+	if k := rv.Kind(); k == reflect.Complex64 || k == reflect.Complex128 {
+		return c.formatComplex(rv)
+	}
+
+	if c.floatVerb != 0 {
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return c.formatFloat(rv)
+		case reflect.Slice, reflect.Array:
+			if isFloatKind(rv.Type().Elem().Kind()) && !(rv.Kind() == reflect.Slice && rv.IsNil()) {
+				parts := make([]string, rv.Len())
+				for i := range parts {
+					parts[i] = c.formatFloat(rv.Index(i))
+				}
+				return fmt.Sprintf("%v{%s}", rv.Type(), strings.Join(parts, ", "))
+			}
+		}
+	}
+
+	s := fmt.Sprintf("%#v", v)
+
+	// Pointers to scalars render as a bare address, so show the pointee too.
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() && strings.HasPrefix(s, "(") {
+		s += " -> " + c.format(rv.Elem().Interface())
+	}
+	return s
+}
+
+func (c *config) formatFloat(rv reflect.Value) string {
+	return strconv.FormatFloat(rv.Float(), c.floatVerb, c.floatPrec, rv.Type().Bits())
+}
+
+// formatComplex renders a complex value as a+bi.
+func (c *config) formatComplex(rv reflect.Value) string {
+	verb, prec := c.floatVerb, c.floatPrec
+	if verb == 0 {
+		verb, prec = 'g', -1
+	}
+	bits := rv.Type().Bits() / 2
+	z := rv.Complex()
+	im := strconv.FormatFloat(imag(z), verb, prec, bits)
+	if im[0] != '-' && im[0] != '+' {
+		im = "+" + im
+	}
+	return strconv.FormatFloat(real(z), verb, prec, bits) + im + "i"
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// formatBig renders math/big values in decimal, and reports whether v was a
+// math/big value.
+func formatBig(v any) (string, bool) {
+	if isNil(v) {
+		switch v.(type) {
+		case *big.Int, *big.Float, *big.Rat:
+			return fmt.Sprintf("(%T)(nil)", v), true
+		}
+		return "", false
+	}
+
+	switch b := v.(type) {
+	case *big.Int:
+		return fmt.Sprintf("*big.Int(%s)", b.String()), true
+	case *big.Float:
+		return fmt.Sprintf("*big.Float(%s)", b.Text('g', -1)), true
+	case *big.Rat:
+		return fmt.Sprintf("*big.Rat(%s)", b.RatString()), true
+	}
+	return "", false
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	// Avoid constant folding, which would compute exactly 0.3.
+	a, b := 0.1, 0.2
+
+	testCases := map[string]struct {
+		val      any
+		opts     []any
+		expected string
+	}{
+		"default float": {
+			val:      a + b,
+			expected: "0.30000000000000004",
+		},
+		"fixed float": {
+			val:      a + b,
+			opts:     []any{FloatFormat('f', 2)},
+			expected: "0.30",
+		},
+		"scientific float": {
+			val:      12345.678,
+			opts:     []any{FloatFormat('e', 3)},
+			expected: "1.235e+04",
+		},
+		"float32": {
+			val:      float32(0.1),
+			opts:     []any{FloatFormat('g', -1)},
+			expected: "0.1",
+		},
+		"float slice": {
+			val:      []float64{1.23456, 2},
+			opts:     []any{FloatFormat('f', 1)},
+			expected: "[]float64{1.2, 2.0}",
+		},
+		"float array": {
+			val:      [2]float32{1.25, 2},
+			opts:     []any{FloatFormat('f', 1)},
+			expected: "[2]float32{1.2, 2.0}",
+		},
+		"nil float slice": {
+			val:      []float64(nil),
+			opts:     []any{FloatFormat('f', 1)},
+			expected: "[]float64(nil)",
+		},
+		"complex": {
+			val:      complex(1, -2.5),
+			expected: "1-2.5i",
+		},
+		"complex64": {
+			val:      complex64(complex(0.1, 0.2)),
+			expected: "0.1+0.2i",
+		},
+		"fixed complex": {
+			val:      complex(1, 2),
+			opts:     []any{FloatFormat('f', 1)},
+			expected: "1.0+2.0i",
+		},
+		"infinite complex": {
+			val:      complex(math.Inf(1), math.Inf(-1)),
+			expected: "+Inf-Infi",
+		},
+		"non-float": {
+			val:      "abc",
+			opts:     []any{FloatFormat('f', 1)},
+			expected: `"abc"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := parseConfig(tc.opts...).format(tc.val)
+			if got != tc.expected {
+				t.Errorf("got: %q; want: %q;", got, tc.expected)
+			}
+		})
+	}
+
+	t.Run("in failure", func(t *testing.T) {
+		tb := &mockTB{}
+		Equal(tb, a+b, 0.3)
+		wantMsg := "got: 0.30000000000000004; want: 0.3;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+
+		tb2 := &mockTB{}
+		Greater(tb2, 1.0/3, 0.5, FloatFormat('f', 3))
+		wantMsg = "got: 0.333; want > 0.500;"
+		if tb2.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb2.msg, wantMsg)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package bridge gives package core access to the internals of package
+// assert, without exporting them from assert. Package assert sets the
+// functions below when it is initialized.
+package bridge
+
+var (
+	// NewConfig builds a config from the trailing arguments of an
+	// assertion, reports those of unsupported types to t, and tallies the
+	// caller of its caller for the usage report.
+	NewConfig func(t any, opts []any) any
+	// Format renders v as assertions do in failure messages.
+	Format func(cfg, v any) string
+	// Msg renders the user supplied messages of cfg.
+	Msg func(cfg any) string
+	// Equal reports whether got and want are equal, as for assert.Equal.
+	Equal func(cfg, got, want any) bool
+)
@@ -0,0 +1,338 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// WriteSpy is an [io.WriteCloser] that records every write, for asserting on
+// how code under test uses a writer. Create one with [SpyWriter].
+type WriteSpy struct {
+	t      TestingT
+	mu     sync.Mutex
+	chunks []string
+	closed bool
+	late   int
+}
+
+// SpyWriter returns a [WriteSpy] whose assertion methods report to t.
+func SpyWriter(t TestingT) *WriteSpy {
+	return &WriteSpy{t: t}
+}
+
+// Write records p. Writes after Close are counted but not recorded, and fail
+// with [os.ErrClosed].
+func (w *WriteSpy) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		w.late++
+		return 0, os.ErrClosed
+	}
+	w.chunks = append(w.chunks, string(p))
+	return len(p), nil
+}
+
+func (w *WriteSpy) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	return nil
+}
+
+// String returns everything written so far.
+func (w *WriteSpy) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return strings.Join(w.chunks, "")
+}
+
+// Chunks returns the individual writes recorded so far.
+func (w *WriteSpy) Chunks() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]string(nil), w.chunks...)
+}
+
+// AssertContent asserts that the concatenated writes equal want.
+func (w *WriteSpy) AssertContent(want string, opts ...any) {
+	if ht, ok := w.t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(w.t, opts...)
+
+	if got := w.String(); got != want {
+		w.t.Fatalf("%s", textMismatch(got, want, cfg))
+	}
+}
+
+// AssertWriteCount asserts that Write was called successfully want times.
+func (w *WriteSpy) AssertWriteCount(want int, opts ...any) {
+	if ht, ok := w.t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(w.t, opts...)
+
+	if got := len(w.Chunks()); got != want {
+		w.t.Fatalf("got: %d writes; want: %d writes;%s", got, want, cfg.msg())
+	}
+}
+
+// AssertChunks asserts that the individual writes, in order, equal want.
+func (w *WriteSpy) AssertChunks(want []string, opts ...any) {
+	if ht, ok := w.t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(w.t, opts...)
+
+	if got := w.Chunks(); !isEqual(got, want, cfg) {
+		w.t.Fatalf("got: %#v; want: %#v;%s", got, want, cfg.msg())
+	}
+}
+
+// AssertNoWritesAfterClose asserts that Write was not called after Close.
+func (w *WriteSpy) AssertNoWritesAfterClose(opts ...any) {
+	if ht, ok := w.t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(w.t, opts...)
+
+	w.mu.Lock()
+	late := w.late
+	w.mu.Unlock()
+	if late > 0 {
+		w.t.Fatalf("got: %d writes after close; want: none;%s", late, cfg.msg())
+	}
+}
+
+// maxEmptyReads bounds the number of consecutive (0, nil) reads tolerated
+// before a reader is considered stuck.
+const maxEmptyReads = 100
+
+// ReadsAll asserts that r yields exactly want before returning [io.EOF], and
+// no other error.
+func ReadsAll(t TestingT, r io.Reader, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	got, err := readToEOF(r)
+	if err != nil {
+		t.Fatalf("unexpected error after %d bytes: %s;%s", len(got), err, cfg.msg())
+		return
+	}
+	if string(got) != want {
+		t.Fatalf("%s", textMismatch(string(got), want, cfg))
+	}
+}
+
+// ReturnsEOFOnce asserts that r eventually returns [io.EOF], and keeps
+// returning 0, io.EOF once it has done so.
+func ReturnsEOFOnce(t TestingT, r io.Reader, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if _, err := readToEOF(r); err != nil {
+		t.Fatalf("got: %v; want: EOF;%s", err, cfg.msg())
+		return
+	}
+
+	buf := make([]byte, 512)
+	n, err := r.Read(buf)
+	if n != 0 || err != io.EOF {
+		t.Fatalf("got: %d bytes, %v after EOF; want: 0 bytes, EOF;%s", n, err, cfg.msg())
+	}
+}
+
+// readToEOF is like [io.ReadAll], but fails on readers that stop making
+// progress instead of looping forever.
+func readToEOF(r io.Reader) ([]byte, error) {
+	var out []byte
+	buf := make([]byte, 512)
+	empty := 0
+	for {
+		n, err := r.Read(buf)
+		out = append(out, buf[:n]...)
+		switch {
+		case err == io.EOF:
+			return out, nil
+		case err != nil:
+			return out, err
+		case n == 0:
+			empty++
+			if empty >= maxEmptyReads {
+				return out, errors.New("reader made no progress")
+			}
+		default:
+			empty = 0
+		}
+	}
+}
+
+// ShortReader returns a reader that returns at most n bytes from r per Read,
+// for testing that callers handle partial reads.
+func ShortReader(r io.Reader, n int) io.Reader {
+	return &shortReader{r: r, n: max(n, 1)}
+}
+
+type shortReader struct {
+	r io.Reader
+	n int
+}
+
+func (s *shortReader) Read(p []byte) (int, error) {
+	if len(p) > s.n {
+		p = p[:s.n]
+	}
+	return s.r.Read(p)
+}
+
+// TrackedCloser wraps an [io.Closer] and counts calls to Close. Create one
+// with [Closes] or [ClosedOnce].
+type TrackedCloser struct {
+	io.Closer
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *TrackedCloser) Close() error {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+	return c.Closer.Close()
+}
+
+// Calls returns the number of times Close has been called.
+func (c *TrackedCloser) Calls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+// Closes wraps c, and asserts when the test finishes that Close was called on
+// the returned wrapper. t must provide Cleanup, as [testing.T] does.
+func Closes(t TestingT, c io.Closer, opts ...any) *TrackedCloser {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	return trackCloser(t, c, false, cfg)
+}
+
+// ClosedOnce is like [Closes], but also asserts that Close was not called
+// more than once.
+func ClosedOnce(t TestingT, c io.Closer, opts ...any) *TrackedCloser {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	return trackCloser(t, c, true, cfg)
+}
+
+func trackCloser(t TestingT, c io.Closer, once bool, cfg *config) *TrackedCloser {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	tc := &TrackedCloser{Closer: c}
+	ct, ok := t.(cleanupT)
+	if !ok {
+		t.Fatalf("close tracking requires a TestingT with Cleanup, got %T", t)
+		return tc
+	}
+
+	ct.Cleanup(func() {
+		switch calls := tc.Calls(); {
+		case calls == 0:
+			t.Errorf("got: Close not called; want: closed;%s", cfg.msg())
+		case once && calls > 1:
+			t.Errorf("got: Close called %d times; want: once;%s", calls, cfg.msg())
+		}
+	})
+	return tc
+}
+
+// NeverMatches asserts when the test finishes that the content captured by
+// captured, such as a [*bytes.Buffer] or a [WriteSpy] collecting output, has
+// no match for re, reporting the first matching line. t must provide Cleanup,
+// as [testing.T] does.
+func NeverMatches(t TestingT, captured fmt.Stringer, re *regexp.Regexp, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	ct, ok := t.(cleanupT)
+	if !ok {
+		t.Fatalf("output checks require a TestingT with Cleanup, got %T", t)
+		return
+	}
+	ct.Cleanup(func() {
+		checkNeverMatches(t, captured.String(), re, "output", cfg)
+	})
+}
+
+// NotLogged asserts when the test finishes that nothing logged through
+// logger in the meantime matches pattern, reporting the first matching line.
+// Log output is still written to the logger's original writer, which is
+// restored when the test finishes. t must provide Cleanup, as [testing.T]
+// does.
+func NotLogged(t TestingT, logger *log.Logger, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
+		return
+	}
+	ct, ok := t.(cleanupT)
+	if !ok {
+		t.Fatalf("output checks require a TestingT with Cleanup, got %T", t)
+		return
+	}
+
+	orig := logger.Writer()
+	spy := SpyWriter(t)
+	logger.SetOutput(io.MultiWriter(orig, spy))
+	ct.Cleanup(func() {
+		logger.SetOutput(orig)
+		checkNeverMatches(t, spy.String(), re, "log", cfg)
+	})
+}
+
+// checkNeverMatches reports the first line of s that matches re, if any.
+func checkNeverMatches(t TestingT, s string, re *regexp.Regexp, what string, cfg *config) {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return
+	}
+	start := strings.LastIndexByte(s[:loc[0]], '\n') + 1
+	end := len(s)
+	if i := strings.IndexByte(s[loc[1]:], '\n'); i >= 0 {
+		end = loc[1] + i
+	}
+	t.Errorf("got: %s line %d: %q; want no match for %q;%s",
+		what, strings.Count(s[:loc[0]], "\n")+1, s[start:end], re, cfg.msg())
+}
@@ -0,0 +1,396 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSpyWriter(t *testing.T) {
+	t.Run("passing", func(t *testing.T) {
+		tb := &mockTB{}
+		w := SpyWriter(tb)
+		fmt.Fprint(w, "hello ")
+		fmt.Fprint(w, "world")
+		if err := w.Close(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		w.AssertContent("hello world")
+		w.AssertWriteCount(2)
+		w.AssertChunks([]string{"hello ", "world"})
+		w.AssertNoWritesAfterClose()
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("buffered", func(t *testing.T) {
+		tb := &mockTB{}
+		w := SpyWriter(tb)
+		bw := bufio.NewWriter(w)
+		fmt.Fprint(bw, "hello ")
+		fmt.Fprint(bw, "world")
+		w.AssertWriteCount(0)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+
+		if err := bw.Flush(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		w.AssertChunks([]string{"hello world"})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		testCases := map[string]struct {
+			check func(w *WriteSpy)
+			msg   string
+		}{
+			"content": {
+				check: func(w *WriteSpy) { w.AssertContent("hello") },
+				msg:   `got: "hello world"; want: "hello";`,
+			},
+			"write count": {
+				check: func(w *WriteSpy) { w.AssertWriteCount(1) },
+				msg:   "got: 2 writes; want: 1 writes;",
+			},
+			"chunks": {
+				check: func(w *WriteSpy) { w.AssertChunks([]string{"hello world"}) },
+				msg:   `got: []string{"hello ", "world"}; want: []string{"hello world"};`,
+			},
+			"writes after close": {
+				check: func(w *WriteSpy) { w.AssertNoWritesAfterClose() },
+				msg:   "got: 1 writes after close; want: none;",
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				tb := &mockTB{}
+				w := SpyWriter(tb)
+				fmt.Fprint(w, "hello ")
+				fmt.Fprint(w, "world")
+				w.Close()
+				if _, err := fmt.Fprint(w, "!"); !errors.Is(err, os.ErrClosed) {
+					t.Errorf("got: %v; want: %v;", err, os.ErrClosed)
+				}
+
+				tc.check(w)
+				if !tb.fatal {
+					t.Error("should be fatal")
+				}
+				if tb.msg != tc.msg {
+					t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+				}
+			})
+		}
+	})
+}
+
+// repeatingEOFReader returns data, and then keeps returning it after EOF.
+type repeatingEOFReader struct {
+	data string
+	eof  bool
+}
+
+func (r *repeatingEOFReader) Read(p []byte) (int, error) {
+	if r.eof {
+		return copy(p, r.data), nil
+	}
+	r.eof = true
+	return copy(p, r.data), io.EOF
+}
+
+// stuckReader never returns data or an error.
+type stuckReader struct{}
+
+func (stuckReader) Read(p []byte) (int, error) {
+	return 0, nil
+}
+
+func TestReadsAll(t *testing.T) {
+	t.Run("reads all", func(t *testing.T) {
+		tb := &mockTB{}
+		ReadsAll(tb, ShortReader(strings.NewReader("hello world"), 3), "hello world")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("data and EOF", func(t *testing.T) {
+		tb := &mockTB{}
+		ReadsAll(tb, iotest.DataErrReader(strings.NewReader("hello")), "hello")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("different content", func(t *testing.T) {
+		tb := &mockTB{}
+		ReadsAll(tb, strings.NewReader("hello"), "world")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: "hello"; want: "world";`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tb := &mockTB{}
+		r := io.MultiReader(strings.NewReader("hello"), iotest.ErrReader(errors.New("oops")))
+		ReadsAll(tb, r, "hello")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "unexpected error after 5 bytes: oops;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("no progress", func(t *testing.T) {
+		tb := &mockTB{}
+		ReadsAll(tb, stuckReader{}, "")
+		wantMsg := "unexpected error after 0 bytes: reader made no progress;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestReturnsEOFOnce(t *testing.T) {
+	t.Run("well behaved", func(t *testing.T) {
+		tb := &mockTB{}
+		ReturnsEOFOnce(tb, strings.NewReader("hello"))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("data after EOF", func(t *testing.T) {
+		tb := &mockTB{}
+		ReturnsEOFOnce(tb, &repeatingEOFReader{data: "hello"})
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got: 5 bytes, <nil> after EOF; want: 0 bytes, EOF;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tb := &mockTB{}
+		ReturnsEOFOnce(tb, iotest.ErrReader(errors.New("oops")))
+		wantMsg := "got: oops; want: EOF;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestShortReader(t *testing.T) {
+	r := ShortReader(strings.NewReader("hello world"), 4)
+	buf := make([]byte, 64)
+	n, err := r.Read(buf)
+	if n != 4 || err != nil {
+		t.Errorf("got: %d, %v; want: 4, <nil>;", n, err)
+	}
+}
+
+// cleanupTB is a mockTB that supports Cleanup.
+type cleanupTB struct {
+	mockTB
+	cleanups []func()
+}
+
+func (c *cleanupTB) Cleanup(fn func()) {
+	c.cleanups = append(c.cleanups, fn)
+}
+
+func (c *cleanupTB) runCleanups() {
+	for i := len(c.cleanups) - 1; i >= 0; i-- {
+		c.cleanups[i]()
+	}
+}
+
+// nopCloser is an io.Closer that does nothing.
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func TestCloses(t *testing.T) {
+	testCases := map[string]struct {
+		track func(tb TestingT) *TrackedCloser
+		calls int
+		msg   string
+	}{
+		"closed": {
+			track: func(tb TestingT) *TrackedCloser { return Closes(tb, nopCloser{}) },
+			calls: 1,
+		},
+		"closed twice": {
+			track: func(tb TestingT) *TrackedCloser { return Closes(tb, nopCloser{}) },
+			calls: 2,
+		},
+		"not closed": {
+			track: func(tb TestingT) *TrackedCloser { return Closes(tb, nopCloser{}) },
+			msg:   "got: Close not called; want: closed;",
+		},
+		"closed once": {
+			track: func(tb TestingT) *TrackedCloser { return ClosedOnce(tb, nopCloser{}) },
+			calls: 1,
+		},
+		"double close": {
+			track: func(tb TestingT) *TrackedCloser { return ClosedOnce(tb, nopCloser{}) },
+			calls: 2,
+			msg:   "got: Close called 2 times; want: once;",
+		},
+		"once not closed": {
+			track: func(tb TestingT) *TrackedCloser { return ClosedOnce(tb, nopCloser{}) },
+			msg:   "got: Close not called; want: closed;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &cleanupTB{}
+			c := tc.track(tb)
+			for range tc.calls {
+				c.Close()
+			}
+			tb.runCleanups()
+
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.failed {
+				t.Error("should have failed")
+			}
+			if tb.fatal {
+				t.Error("should not be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+
+	t.Run("without cleanup", func(t *testing.T) {
+		rec := &RecordingT{}
+		Closes(rec, nopCloser{})
+		if !FailedFatally(rec) {
+			t.Error("should be fatal")
+		}
+		wantErr := "close tracking requires a TestingT with Cleanup, got *assert.RecordingT"
+		if err := rec.Err(); err == nil || err.Error() != wantErr {
+			t.Errorf("got: %v; want: %q;", err, wantErr)
+		}
+	})
+}
+
+func TestNeverMatches(t *testing.T) {
+	secret := regexp.MustCompile(`token=\w+`)
+
+	t.Run("clean", func(t *testing.T) {
+		tb := &cleanupTB{}
+		var buf bytes.Buffer
+		NeverMatches(tb, &buf, secret)
+		buf.WriteString("user=bob\n")
+		tb.runCleanups()
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("matched", func(t *testing.T) {
+		tb := &cleanupTB{}
+		var buf bytes.Buffer
+		NeverMatches(tb, &buf, secret, "stdout")
+		buf.WriteString("starting\nauth user=bob token=abc123 ok\ndone\n")
+		if tb.failed {
+			t.Fatalf("failed before cleanup: %s", tb.msg)
+		}
+		tb.runCleanups()
+		wantMsg := `got: output line 2: "auth user=bob token=abc123 ok"; want no match for "token=\\w+"; stdout`
+		if tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: fatal=%v %q; want: %q;", tb.fatal, tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("no cleanup", func(t *testing.T) {
+		rec := &RecordingT{}
+		NeverMatches(rec, &bytes.Buffer{}, secret)
+		if f := rec.Failures(); len(f) != 1 || f[0].Message != "output checks require a TestingT with Cleanup, got *assert.RecordingT" {
+			t.Errorf("unexpected failures: %v", f)
+		}
+	})
+}
+
+func TestNotLogged(t *testing.T) {
+	t.Run("logged", func(t *testing.T) {
+		var out bytes.Buffer
+		logger := log.New(&out, "", 0)
+		tb := &cleanupTB{}
+		NotLogged(tb, logger, `(?i)deprecated`)
+		logger.Print("starting")
+		logger.Print("option -x is Deprecated")
+		tb.runCleanups()
+
+		wantMsg := `got: log line 2: "option -x is Deprecated"; want no match for "(?i)deprecated";`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+		if got := out.String(); got != "starting\noption -x is Deprecated\n" {
+			t.Errorf("original output not written: %q", got)
+		}
+		if logger.Writer() != &out {
+			t.Error("original output not restored")
+		}
+	})
+
+	t.Run("not logged", func(t *testing.T) {
+		logger := log.New(io.Discard, "", 0)
+		tb := &cleanupTB{}
+		NotLogged(tb, logger, `panic`)
+		logger.Print("all good")
+		tb.runCleanups()
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("bad regex", func(t *testing.T) {
+		tb := &cleanupTB{}
+		NotLogged(tb, log.Default(), `[`)
+		if !tb.fatal || !strings.HasPrefix(tb.msg, "unable to parse regexp pattern [: ") {
+			t.Errorf("got: fatal=%v %q; want fatal parse error;", tb.fatal, tb.msg)
+		}
+		if len(tb.cleanups) != 0 {
+			t.Error("should not register a cleanup")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// treeDiff lists the differences between two JSON-like trees of
+// map[string]any and []any, one line per path, as in:
+//
+//	added   .meta.extra: "x"
+//	removed .items[2]: 3
+//	changed .name: got "a", want "b"
+//	changed .id: got string "1", want float64 1
+//
+// Added paths are present only in got, removed paths only in want. A change
+// of type is reported with both types.
+func treeDiff(got, want any, cfg *config) []string {
+	d := &treeDiffer{cmp: &deepComparer{cfg: cfg}}
+	d.walk("", got, want)
+	return d.lines
+}
+
+type treeDiffer struct {
+	cmp   *deepComparer
+	lines []string
+}
+
+func (d *treeDiffer) walk(path string, got, want any) {
+	switch g := got.(type) {
+	case map[string]any:
+		if w, ok := want.(map[string]any); ok {
+			keys := make([]string, 0, len(g)+len(w))
+			for k := range g {
+				keys = append(keys, k)
+			}
+			for k := range w {
+				if _, ok := g[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			slices.Sort(keys)
+			for _, k := range keys {
+				gv, inGot := g[k]
+				wv, inWant := w[k]
+				d.entry(treeKeyPath(path, k), gv, wv, inGot, inWant)
+			}
+			return
+		}
+	case []any:
+		if w, ok := want.([]any); ok {
+			for i := range max(len(g), len(w)) {
+				var gv, wv any
+				if i < len(g) {
+					gv = g[i]
+				}
+				if i < len(w) {
+					wv = w[i]
+				}
+				d.entry(fmt.Sprintf("%s[%d]", path, i), gv, wv, i < len(g), i < len(w))
+			}
+			return
+		}
+	}
+
+	if d.cmp.compare(reflect.ValueOf(got), reflect.ValueOf(want)) {
+		return
+	}
+	if path == "" {
+		path = "."
+	}
+	if reflect.TypeOf(got) != reflect.TypeOf(want) {
+		d.lines = append(d.lines, fmt.Sprintf("changed %s: got %s, want %s", path, typedValue(got), typedValue(want)))
+		return
+	}
+	d.lines = append(d.lines, fmt.Sprintf("changed %s: got %#v, want %#v", path, got, want))
+}
+
+func (d *treeDiffer) entry(path string, got, want any, inGot, inWant bool) {
+	switch {
+	case !inWant:
+		d.lines = append(d.lines, fmt.Sprintf("added   %s: %#v", path, got))
+	case !inGot:
+		d.lines = append(d.lines, fmt.Sprintf("removed %s: %#v", path, want))
+	default:
+		d.walk(path, got, want)
+	}
+}
+
+// typedValue renders v along with its type.
+func typedValue(v any) string {
+	if v == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%T %#v", v, v)
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// treeKeyPath appends map key k to path, quoting k unless it is a plain
+// identifier.
+func treeKeyPath(path, k string) string {
+	if identifier.MatchString(k) {
+		return path + "." + k
+	}
+	return path + "[" + strconv.Quote(k) + "]"
+}
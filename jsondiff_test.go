@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestTreeDiff(t *testing.T) {
+	decode := func(s string) map[string]any {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal": {check: func(tb TestingT) {
+			Equal(tb, decode(`{"a": 1, "b": [1, {"c": true}]}`), decode(`{"b": [1, {"c": true}], "a": 1}`))
+		}},
+		"nan": {check: func(tb TestingT) {
+			Equal(tb, map[string]any{"x": math.NaN()}, map[string]any{"x": math.NaN()}, EquateNaNs())
+		}},
+		"differ": {
+			check: func(tb TestingT) {
+				Equal(tb,
+					decode(`{"id": "1", "name": "a", "meta": {"extra": "x"}, "items": [1, 2], "x-y": null}`),
+					decode(`{"id": 1, "name": "b", "meta": {}, "items": [1, 2, 3], "x-y": null}`),
+					"payload")
+			},
+			msg: "got and want differ; payload\n" +
+				`  changed .id: got string "1", want float64 1` + "\n" +
+				"  removed .items[2]: 3\n" +
+				`  added   .meta.extra: "x"` + "\n" +
+				`  changed .name: got "a", want "b"`,
+		},
+		"quoted key": {
+			check: func(tb TestingT) {
+				Equal(tb, map[string]any{"x-y": nil}, map[string]any{"x-y": false})
+			},
+			msg: "got and want differ;\n  changed [\"x-y\"]: got <nil>, want bool false",
+		},
+		"nested list": {
+			check: func(tb TestingT) {
+				Equal(tb, decode(`{"a": [[1, 2]]}`), decode(`{"a": [[1, 3]]}`))
+			},
+			msg: "got and want differ;\n  changed .a[0][1]: got 2, want 3",
+		},
+		"as any": {
+			check: func(tb TestingT) {
+				Equal[any](tb, map[string]any{"a": 1}, []any{1})
+			},
+			msg: "got and want differ;\n  changed .: got map[string]interface {} map[string]interface {}{\"a\":1}, want []interface {} []interface {}{1}",
+		},
+		"nil and empty": {
+			check: func(tb TestingT) { Equal(tb, map[string]any(nil), map[string]any{}) },
+			msg:   "got: map[string]interface {}(nil); want: map[string]interface {}{};",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
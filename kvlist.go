@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DuplicatePolicy decides how [KVListEqual] treats keys that occur more than
+// once in a list.
+type DuplicatePolicy int
+
+const (
+	// LastWins keeps the last value of a repeated key, as [os/exec] does for
+	// environment variables. It is the default.
+	LastWins DuplicatePolicy = iota
+	// FirstWins keeps the first value of a repeated key.
+	FirstWins
+	// KeepAll keeps every value of a repeated key, in order, as for HTTP
+	// header lists.
+	KeepAll
+	// RejectDuplicates fails if either list repeats a key.
+	RejectDuplicates
+)
+
+// Duplicates sets how [KVListEqual] treats repeated keys.
+func Duplicates(policy DuplicatePolicy) Option {
+	return optionFunc(func(c *config) {
+		c.duplicates = policy
+	})
+}
+
+// KVListEqual asserts that two lists of "key<sep>value" entries, such as
+// those of [os.Environ], hold the same values per key regardless of order.
+// Keys are compared case-sensitively, and an entry without sep is a key with
+// an empty value. Repeated keys are handled as set by [Duplicates].
+func KVListEqual(t TestingT, got, want []string, sep string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	gotKV, gotDups := parseKVList(got, sep, cfg.duplicates)
+	wantKV, wantDups := parseKVList(want, sep, cfg.duplicates)
+	if cfg.duplicates == RejectDuplicates && (len(gotDups) > 0 || len(wantDups) > 0) {
+		var b strings.Builder
+		if len(gotDups) > 0 {
+			fmt.Fprintf(&b, " got: %q;", gotDups)
+		}
+		if len(wantDups) > 0 {
+			fmt.Fprintf(&b, " want: %q;", wantDups)
+		}
+		t.Fatalf("duplicate keys;%s%s", b.String(), cfg.msg())
+		return
+	}
+
+	var diffs []string
+	for _, key := range sortedKeys(mergeKeys(gotKV, wantKV)) {
+		g, w := gotKV[key], wantKV[key]
+		switch {
+		case g == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: missing; want %s", key, formatKVValues(w)))
+		case w == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: got %s; want none", key, formatKVValues(g)))
+		case !slices.Equal(g, w):
+			diffs = append(diffs, fmt.Sprintf("%s: got %s; want %s", key, formatKVValues(g), formatKVValues(w)))
+		}
+	}
+	if len(diffs) > 0 {
+		t.Fatalf("got and want differ;%s\n  %s", cfg.msg(), strings.Join(diffs, "\n  "))
+	}
+}
+
+// parseKVList splits each entry of list at the first sep, and groups the
+// values by key according to policy. It also returns the keys that occur
+// more than once, in order of first repetition.
+func parseKVList(list []string, sep string, policy DuplicatePolicy) (map[string][]string, []string) {
+	kv := make(map[string][]string, len(list))
+	counts := make(map[string]int, len(list))
+	var dups []string
+	for _, entry := range list {
+		key, value, _ := strings.Cut(entry, sep)
+		prev, seen := kv[key]
+		if counts[key]++; counts[key] == 2 {
+			dups = append(dups, key)
+		}
+		switch {
+		case !seen || policy == LastWins || policy == RejectDuplicates:
+			kv[key] = []string{value}
+		case policy == KeepAll:
+			kv[key] = append(prev, value)
+		}
+	}
+	return kv, dups
+}
+
+// mergeKeys returns a set of the keys of a and b.
+func mergeKeys(a, b map[string][]string) map[string]bool {
+	keys := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+	return keys
+}
+
+func formatKVValues(values []string) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("%q", values[0])
+	}
+	return fmt.Sprintf("%q", values)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+func TestKVListEqual(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal": {check: func(tb TestingT) {
+			KVListEqual(tb, []string{"A=1", "B=2=3", "C"}, []string{"C=", "B=2=3", "A=1"}, "=")
+		}},
+		"last wins": {check: func(tb TestingT) {
+			KVListEqual(tb, []string{"A=1", "A=2"}, []string{"A=2"}, "=")
+		}},
+		"first wins": {check: func(tb TestingT) {
+			KVListEqual(tb, []string{"A=1", "A=2"}, []string{"A=1"}, "=", Duplicates(FirstWins))
+		}},
+		"keep all": {check: func(tb TestingT) {
+			KVListEqual(tb, []string{"Accept: a", "Host: h", "Accept: b"}, []string{"Host: h", "Accept: a", "Accept: b"}, ": ", Duplicates(KeepAll))
+		}},
+		"differ": {
+			check: func(tb TestingT) {
+				KVListEqual(tb, []string{"PATH=/bin", "HOME=/root", "path=/usr/bin", "TERM=xterm"}, []string{"PATH=/usr/bin", "HOME=/root", "USER=me"}, "=", "env")
+			},
+			msg: "got and want differ; env\n" +
+				`  PATH: got "/bin"; want "/usr/bin"` + "\n" +
+				`  TERM: got "xterm"; want none` + "\n" +
+				`  USER: missing; want "me"` + "\n" +
+				`  path: got "/usr/bin"; want none`,
+		},
+		"keep all order": {
+			check: func(tb TestingT) {
+				KVListEqual(tb, []string{"Accept: a", "Accept: b"}, []string{"Accept: b", "Accept: a"}, ": ", Duplicates(KeepAll))
+			},
+			msg: "got and want differ;\n" + `  Accept: got ["a" "b"]; want ["b" "a"]`,
+		},
+		"reject duplicates": {
+			check: func(tb TestingT) {
+				KVListEqual(tb, []string{"A=1", "A=2", "A=3", "B=1", "B=1"}, []string{"A=3", "B=1"}, "=", Duplicates(RejectDuplicates))
+			},
+			msg: `duplicate keys; got: ["A" "B"];`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
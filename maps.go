@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MapSubset asserts that every key of want is in got with an equal value.
+// Keys of got that are not in want are ignored. Values are compared as by
+// [Equal].
+func MapSubset[K comparable, V any](t TestingT, got, want map[K]V, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var missing, differs []string
+	for _, k := range sortedKeys(want) {
+		g, ok := got[k]
+		switch {
+		case !ok:
+			missing = append(missing, cfg.format(k))
+		case !isEqual(g, want[k], cfg):
+			differs = append(differs, fmt.Sprintf("%s: got %s, want %s", cfg.format(k), cfg.format(g), cfg.format(want[k])))
+		}
+	}
+	if len(missing) == 0 && len(differs) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	if len(missing) > 0 {
+		b.WriteString(" missing keys: " + strings.Join(missing, ", ") + ";")
+	}
+	if len(differs) > 0 {
+		b.WriteString(" different values: " + strings.Join(differs, "; ") + ";")
+	}
+	t.Fatalf("got map without all entries of want;%s%s", b.String(), cfg.msg())
+}
+
+// HasKey asserts that m has the given key. On failure the keys of m are
+// listed in sorted order.
+func HasKey[K comparable, V any](t TestingT, m map[K]V, key K, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if _, ok := m[key]; !ok {
+		t.Fatalf("got: keys %s; want: key %s;%s", formatKeys(m, cfg), cfg.format(key), cfg.msg())
+	}
+}
+
+// HasValue asserts that m has a value equal to value, as by [Equal]. On
+// failure the keys of m are listed in sorted order.
+func HasValue[K comparable, V any](t TestingT, m map[K]V, value V, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	for _, v := range m {
+		if isEqual(v, value, cfg) {
+			return
+		}
+	}
+	t.Fatalf("got: keys %s; want: value %s;%s", formatKeys(m, cfg), cfg.format(value), cfg.msg())
+}
+
+// NilNotEmptyMap asserts that m is nil rather than merely empty, for
+// contracts that tell the two apart, such as JSON null versus {}.
+func NilNotEmptyMap[K comparable, V any](t TestingT, m map[K]V, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if m != nil {
+		t.Fatalf("got: %s; want: nil map;%s", cfg.format(m), cfg.msg())
+	}
+}
+
+// EmptyNotNilMap asserts that m is empty but not nil, for contracts that
+// tell the two apart, such as JSON {} versus null.
+func EmptyNotNilMap[K comparable, V any](t TestingT, m map[K]V, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if m == nil || len(m) > 0 {
+		t.Fatalf("got: %s; want: empty non-nil map;%s", cfg.format(m), cfg.msg())
+	}
+}
+
+// formatKeys renders the sorted keys of m as a list.
+func formatKeys[K comparable, V any](m map[K]V, cfg *config) string {
+	keys := sortedKeys(m)
+	formatted := make([]string, len(keys))
+	for i, k := range keys {
+		formatted[i] = cfg.format(k)
+	}
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
+
+// KeysEqual asserts that the keys of m are exactly wantKeys, in any order.
+func KeysEqual[K comparable, V any](t TestingT, m map[K]V, wantKeys []K, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	keys := sortedKeys(m)
+	if missing, extra := multisetDiff(keys, wantKeys, cfg); len(missing) > 0 || len(extra) > 0 {
+		t.Fatalf("got: keys %s; want: keys %s in any order;%s%s",
+			cfg.format(keys), cfg.format(wantKeys), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+	}
+}
+
+// ValuesMatch asserts that the values of m are exactly wantValues, in any
+// order. Each value must occur as many times in m as in wantValues. Values are
+// compared as by [Equal].
+func ValuesMatch[K comparable, V any](t TestingT, m map[K]V, wantValues []V, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	// Listed in key order, so failure messages are stable.
+	keys := sortedKeys(m)
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	if missing, extra := multisetDiff(values, wantValues, cfg); len(missing) > 0 || len(extra) > 0 {
+		t.Fatalf("got: values %s; want: values %s in any order;%s%s",
+			cfg.format(values), cfg.format(wantValues), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+	}
+}
+
+// MapKeysMatch asserts that every key of m matches the regexp pattern, such
+// as "^app\\.example\\.com/" for a prefix, listing the keys that don't.
+func MapKeysMatch[K ~string, V any](t TestingT, m map[K]V, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
+		return
+	}
+	if offenders := keysMatching(m, re, false); len(offenders) > 0 {
+		t.Fatalf("got: keys %q not matching; want: all keys to match %q;%s", offenders, pattern, cfg.msg())
+	}
+}
+
+// NoKeysMatching asserts that no key of m matches the regexp pattern, listing
+// the keys that do.
+func NoKeysMatching[K ~string, V any](t TestingT, m map[K]V, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("unable to parse regexp pattern %s: %s", pattern, err.Error())
+		return
+	}
+	if offenders := keysMatching(m, re, true); len(offenders) > 0 {
+		t.Fatalf("got: keys %q matching; want: no keys to match %q;%s", offenders, pattern, cfg.msg())
+	}
+}
+
+// keysMatching returns the sorted keys of m that match re if matching is
+// set, or that don't match it otherwise.
+func keysMatching[K ~string, V any](m map[K]V, re *regexp.Regexp, matching bool) []string {
+	var keys []string
+	for _, k := range sortedKeys(m) {
+		if re.MatchString(string(k)) == matching {
+			keys = append(keys, string(k))
+		}
+	}
+	return keys
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+// label is a named string type for map keys.
+type label string
+
+func TestMaps(t *testing.T) {
+	config := map[string]any{"host": "localhost", "port": 8080, "tls": map[string]any{"enabled": true}}
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"subset":        {check: func(tb TestingT) { MapSubset(tb, config, map[string]any{"port": 8080}) }},
+		"subset nested": {check: func(tb TestingT) { MapSubset(tb, config, map[string]any{"tls": map[string]any{"enabled": true}}) }},
+		"subset empty":  {check: func(tb TestingT) { MapSubset(tb, nil, map[int]int{}) }},
+		"subset equaler": {check: func(tb TestingT) {
+			MapSubset(tb, map[int]noisy{1: newNoisy(1), 2: newNoisy(2)}, map[int]noisy{1: newNoisy(1)})
+		}},
+		"subset mismatch": {
+			check: func(tb TestingT) {
+				MapSubset(tb, config, map[string]any{"port": 80, "user": "root", "host": "localhost", "debug": true}, "config")
+			},
+			msg: `got map without all entries of want; missing keys: "debug", "user"; different values: "port": got 8080, want 80; config`,
+		},
+		"has key": {check: func(tb TestingT) { HasKey(tb, config, "tls") }},
+		"missing key": {
+			check: func(tb TestingT) { HasKey(tb, config, "user", "config") },
+			msg:   `got: keys ["host", "port", "tls"]; want: key "user"; config`,
+		},
+		"missing key nil map": {
+			check: func(tb TestingT) { HasKey(tb, map[int]bool(nil), 3) },
+			msg:   "got: keys []; want: key 3;",
+		},
+		"has value":         {check: func(tb TestingT) { HasValue(tb, config, any(8080)) }},
+		"has value equaler": {check: func(tb TestingT) { HasValue(tb, map[string]noisy{"a": newNoisy(1)}, newNoisy(1)) }},
+		"missing value": {
+			check: func(tb TestingT) { HasValue(tb, map[int]string{10: "a", 2: "b"}, "c") },
+			msg:   `got: keys [2, 10]; want: value "c";`,
+		},
+		"nil map": {check: func(tb TestingT) { NilNotEmptyMap(tb, map[string]int(nil)) }},
+		"empty not nil map": {
+			check: func(tb TestingT) { NilNotEmptyMap(tb, map[string]int{}, "labels") },
+			msg:   "got: map[string]int{}; want: nil map; labels",
+		},
+		"empty map": {check: func(tb TestingT) { EmptyNotNilMap(tb, map[string]int{}) }},
+		"nil not empty map": {
+			check: func(tb TestingT) { EmptyNotNilMap(tb, map[string]int(nil)) },
+			msg:   "got: map[string]int(nil); want: empty non-nil map;",
+		},
+		"non-empty map": {
+			check: func(tb TestingT) { EmptyNotNilMap(tb, map[string]int{"a": 1}) },
+			msg:   `got: map[string]int{"a":1}; want: empty non-nil map;`,
+		},
+		"keys equal":       {check: func(tb TestingT) { KeysEqual(tb, config, []string{"tls", "host", "port"}) }},
+		"keys equal empty": {check: func(tb TestingT) { KeysEqual(tb, map[int]int(nil), nil) }},
+		"keys differ": {
+			check: func(tb TestingT) { KeysEqual(tb, config, []string{"port", "user", "host"}) },
+			msg:   `got: keys []string{"host", "port", "tls"}; want: keys []string{"port", "user", "host"} in any order; missing: []string{"user"}; extra: []string{"tls"};`,
+		},
+		"values match": {check: func(tb TestingT) { ValuesMatch(tb, map[string]int{"a": 1, "b": 2, "c": 1}, []int{1, 1, 2}) }},
+		"values differ": {
+			check: func(tb TestingT) { ValuesMatch(tb, map[string]int{"a": 1, "b": 2, "c": 1}, []int{1, 2, 2}, "counts") },
+			msg:   "got: values []int{1, 2, 1}; want: values []int{1, 2, 2} in any order; missing: []int{2}; extra: []int{1}; counts",
+		},
+		"keys match": {check: func(tb TestingT) {
+			MapKeysMatch(tb, map[string]int{"app.kubernetes.io/name": 1, "app.kubernetes.io/part-of": 2}, `^app\.kubernetes\.io/`)
+		}},
+		"keys match empty": {check: func(tb TestingT) { MapKeysMatch(tb, map[string]int(nil), `^x`) }},
+		"keys not matching": {
+			check: func(tb TestingT) {
+				MapKeysMatch(tb, map[string]int{"team": 1, "app.io/name": 2, "Env": 3}, `^[a-z.]+/`, "labels")
+			},
+			msg: `got: keys ["Env" "team"] not matching; want: all keys to match "^[a-z.]+/"; labels`,
+		},
+		"no keys matching": {check: func(tb TestingT) { NoKeysMatching(tb, config, `^_`) }},
+		"keys matching": {
+			check: func(tb TestingT) { NoKeysMatching(tb, map[label]int{"_tmp": 1, "ok": 2, "_x": 3}, `^_`) },
+			msg:   `got: keys ["_tmp" "_x"] matching; want: no keys to match "^_";`,
+		},
+		"keys bad pattern": {
+			check: func(tb TestingT) { NoKeysMatching(tb, config, `[`) },
+			msg:   "unable to parse regexp pattern [: error parsing regexp: missing closing ]: `[`",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
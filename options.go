@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Option configures a single assertion call. Options are passed in the
+// trailing variadic argument of an assertion, mixed freely with plain string
+// messages.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) {
+	f(c)
+}
+
+// config holds the per-call settings built from an assertion's trailing
+// arguments.
+type config struct {
+	msgs      []string
+	exclusive bool
+	floatVerb byte
+	floatPrec int
+
+	equateNaNs   bool
+	approx       bool
+	approxFrac   float64
+	approxMargin float64
+
+	approxTime    bool
+	approxTimeDur time.Duration
+
+	describe func() string
+	clk      Clock
+
+	duplicates DuplicatePolicy
+
+	unsupported []any
+}
+
+// newConfig builds a config from the trailing arguments of an assertion.
+//
+// Option values are applied in order. Plain strings are literal messages and
+// are never interpreted as format strings, so stray verbs such as "%d" are
+// printed as-is; formatted messages are added with [Msgf]. Arguments of any
+// other type are reported to t as a failure, so that mistakes such as passing
+// EquateNaNs instead of EquateNaNs() are not silently ignored.
+//
+// newConfig must be called directly by the assertion, as it also tallies the
+// assertion for the usage report.
+func newConfig(t TestingT, opts ...any) *config {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	countUsage(1)
+	c := parseConfig(opts...)
+	c.reportUnsupported(t)
+	return c
+}
+
+// parseConfig builds a config from the trailing arguments of an assertion,
+// as described for newConfig, without tallying usage or reporting arguments
+// of unsupported types.
+func parseConfig(opts ...any) *config {
+	c := &config{}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case Option:
+			o.apply(c)
+		case string:
+			c.msgs = append(c.msgs, o)
+		default:
+			c.unsupported = append(c.unsupported, o)
+		}
+	}
+	return c
+}
+
+// reportUnsupported reports the trailing arguments of unsupported types
+// collected by parseConfig to t.
+func (c *config) reportUnsupported(t TestingT) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	for _, o := range c.unsupported {
+		var hint string
+		if reflect.TypeOf(o) != nil && reflect.TypeOf(o).Kind() == reflect.Func {
+			hint = " hint: call the function to get an Option;"
+		}
+		t.Errorf("unsupported argument of type %T; want an Option or a string message; use Msgf for formatted messages;%s", o, hint)
+	}
+}
+
+// msg renders the user supplied messages for a failure line.
+func (c *config) msg() string {
+	return formatMsg(c.msgs...)
+}
+
+// Msg adds a literal message to the failure output.
+func Msg(s string) Option {
+	return optionFunc(func(c *config) {
+		c.msgs = append(c.msgs, s)
+	})
+}
+
+// Msgs adds literal messages to the failure output. It takes the place of
+// passing a []string of messages with msgs..., which the trailing arguments
+// of assertions no longer accept.
+func Msgs(msgs ...string) Option {
+	return optionFunc(func(c *config) {
+		c.msgs = append(c.msgs, msgs...)
+	})
+}
+
+// Msgf adds a formatted message to the failure output.
+func Msgf(format string, args ...any) Option {
+	return optionFunc(func(c *config) {
+		c.msgs = append(c.msgs, fmt.Sprintf(format, args...))
+	})
+}
+
+// Exclusive makes range assertions such as [Between] exclude their bounds.
+func Exclusive() Option {
+	return optionFunc(func(c *config) {
+		c.exclusive = true
+	})
+}
+
+// Describe sets a function that polling assertions such as [Eventually] call
+// on failure to report the last observed state.
+func Describe(fn func() string) Option {
+	return optionFunc(func(c *config) {
+		c.describe = fn
+	})
+}
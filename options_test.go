@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMessages(t *testing.T) {
+	testCases := map[string]struct {
+		opts     []any
+		expected string
+	}{
+		"none": {
+			opts:     nil,
+			expected: "",
+		},
+		"plain string": {
+			opts:     []any{"one"},
+			expected: " one",
+		},
+		"plain strings": {
+			opts:     []any{"one", "two"},
+			expected: " one; two",
+		},
+		"stray verb": {
+			opts:     []any{"100%d done"},
+			expected: " 100%d done",
+		},
+		"leading empty string": {
+			opts:     []any{"", "two"},
+			expected: " two",
+		},
+		"Msg": {
+			opts:     []any{Msg("50%s off")},
+			expected: " 50%s off",
+		},
+		"Msgf": {
+			opts:     []any{Msgf("%d times around the moon", 3)},
+			expected: " 3 times around the moon",
+		},
+		"Msgs": {
+			opts:     []any{Msgs([]string{"one", "100%d"}...)},
+			expected: " one; 100%d",
+		},
+		"mixed": {
+			opts:     []any{Msgf("attempt %d", 2), "one"},
+			expected: " attempt 2; one",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := parseConfig(tc.opts...).msg()
+			if got != tc.expected {
+				t.Errorf("got: %q; want: %q;", got, tc.expected)
+			}
+		})
+	}
+
+	t.Run("unsupported arguments", func(t *testing.T) {
+		rec := &RecordingT{}
+		Equal(rec, 1, 2, "retry", 3, EquateNaNs)
+		var msgs []string
+		for _, f := range rec.Failures() {
+			msgs = append(msgs, f.Severity.String()+": "+f.Message)
+		}
+		wantMsgs := []string{
+			"error: unsupported argument of type int; want an Option or a string message; use Msgf for formatted messages;",
+			"error: unsupported argument of type func() assert.Option; want an Option or a string message; use Msgf for formatted messages; hint: call the function to get an Option;",
+			"fatal: got: 1; want: 2; retry",
+		}
+		if !slices.Equal(msgs, wantMsgs) {
+			t.Errorf("got: %q; want: %q;", msgs, wantMsgs)
+		}
+	})
+
+	t.Run("in failure", func(t *testing.T) {
+		tb := &mockTB{}
+		Equal(tb, 1, 2, Msgf("%d times around the moon", 3))
+		wantMsg := "got: 1; want: 2; 3 times around the moon"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
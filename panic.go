@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+func Panics(t TestingT, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if panicked, _ := catchPanic(fn); !panicked {
+		t.Fatalf("got: no panic; want: panic;%s", cfg.msg())
+	}
+}
+
+func NotPanics(t TestingT, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if panicked, got := catchPanic(fn); panicked {
+		t.Fatalf("got: panic(%#v); want: no panic;%s", got, cfg.msg())
+	}
+}
+
+// PanicsWithValue asserts that fn panics with a value equal to want.
+func PanicsWithValue(t TestingT, want any, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	panicked, got := catchPanic(fn)
+	switch {
+	case !panicked:
+		t.Fatalf("got: no panic; want: panic(%#v);%s", want, cfg.msg())
+	case !isEqual(got, want, cfg):
+		t.Fatalf("got: panic(%#v); want: panic(%#v);%s", got, want, cfg.msg())
+	}
+}
+
+// PanicsWithError asserts that fn panics with an error matching want, using
+// the same rules as [Error]: want may be an error, a substring of the error
+// message, a [reflect.Type], a [*regexp.Regexp] matching the error message, or
+// a func(error) bool predicate.
+func PanicsWithError(t TestingT, want any, fn func(), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	panicked, got := catchPanic(fn)
+	if !panicked {
+		t.Fatalf("got: no panic; want: panic with error;%s", cfg.msg())
+		return
+	}
+
+	err, ok := got.(error)
+	if !ok {
+		t.Fatalf("got: panic(%#v); want: panic with error;%s", got, cfg.msg())
+		return
+	}
+
+	if want == nil {
+		t.Fatalf("unsupported want type: <nil>")
+		return
+	}
+
+	if failure := errorMismatch(err, want); failure != "" {
+		t.Fatalf("%s%s%s", failure, cfg.msg(), errorDetail(err))
+	}
+}
+
+// catchPanic calls fn and reports whether it panicked, along with the
+// recovered value.
+func catchPanic(fn func()) (panicked bool, value any) {
+	panicked = true
+	defer func() {
+		if panicked {
+			value = recover()
+		}
+	}()
+
+	fn()
+	panicked = false
+	return
+}
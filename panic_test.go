@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"testing"
+)
+
+func TestPanics(t *testing.T) {
+	t.Run("panics", func(t *testing.T) {
+		tb := &mockTB{}
+		Panics(tb, func() { panic("oops") })
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+
+		tb2 := &mockTB{}
+		NotPanics(tb2, func() { panic("oops") })
+		if !tb2.failed {
+			t.Error("should have failed")
+		}
+		if !tb2.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: panic("oops"); want: no panic;`
+		if tb2.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb2.msg, wantMsg)
+		}
+	})
+
+	t.Run("does not panic", func(t *testing.T) {
+		tb := &mockTB{}
+		Panics(tb, func() {})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got: no panic; want: panic;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q", tb.msg, wantMsg)
+		}
+
+		tb2 := &mockTB{}
+		NotPanics(tb2, func() {})
+		if tb2.failed {
+			t.Errorf("failed: %s", tb2.msg)
+		}
+	})
+}
+
+func TestPanicsWithValue(t *testing.T) {
+	testCases := map[string]struct {
+		fn   func()
+		want any
+		msg  string
+	}{
+		"same value": {
+			fn:   func() { panic(42) },
+			want: 42,
+		},
+		"different value": {
+			fn:   func() { panic(42) },
+			want: 84,
+			msg:  "got: panic(42); want: panic(84);",
+		},
+		"no panic": {
+			fn:   func() {},
+			want: 42,
+			msg:  "got: no panic; want: panic(42);",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			PanicsWithValue(tb, tc.want, tc.fn)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestPanicsWithError(t *testing.T) {
+	errOops := errors.New("oops")
+
+	testCases := map[string]struct {
+		fn   func()
+		want any
+		msg  string
+	}{
+		"same error": {
+			fn:   func() { panic(errOops) },
+			want: errOops,
+		},
+		"wrapped error": {
+			fn:   func() { panic(fmt.Errorf("wrapped: %w", errOops)) },
+			want: errOops,
+		},
+		"substring": {
+			fn:   func() { panic(errors.New("the night is dark")) },
+			want: "night is",
+		},
+		"type": {
+			fn:   func() { panic(errType("oops")) },
+			want: reflect.TypeFor[errType](),
+		},
+		"different error": {
+			fn:   func() { panic(errors.New("error 1")) },
+			want: errors.New("error 2"),
+			msg:  "got: *errors.errorString(error 1); want: *errors.errorString(error 2);",
+		},
+		"different type": {
+			fn:   func() { panic(errType("oops")) },
+			want: reflect.TypeFor[*fs.PathError](),
+			msg:  "got: assert.errType; want: *fs.PathError;",
+		},
+		"not an error": {
+			fn:   func() { panic("oops") },
+			want: "oops",
+			msg:  `got: panic("oops"); want: panic with error;`,
+		},
+		"no panic": {
+			fn:   func() {},
+			want: errOops,
+			msg:  "got: no panic; want: panic with error;",
+		},
+		"nil want": {
+			fn:   func() { panic(errOops) },
+			want: nil,
+			msg:  "unsupported want type: <nil>",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			PanicsWithError(tb, tc.want, tc.fn)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParsesAsTime asserts that s parses with layout, as by [time.Parse], to a
+// time equal to want, and returns the parsed time. Times in different
+// locations are equal if they denote the same instant.
+func ParsesAsTime(t TestingT, s, layout string, want time.Time, opts ...any) time.Time {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	got, err := time.Parse(layout, s)
+	if err != nil {
+		t.Fatalf("got: %q; want: time in layout %q; error: %v;%s", s, layout, err, cfg.msg())
+		return got
+	}
+	if !got.Equal(want) {
+		t.Fatalf("got: %q (%s); want: %s;%s",
+			s, got.Format(time.RFC3339Nano), want.Format(time.RFC3339Nano), cfg.msg())
+	}
+	return got
+}
+
+// ParsesAsFloat asserts that s, with surrounding white space removed, parses
+// as by [strconv.ParseFloat] to a number within delta of want, and returns the
+// parsed number.
+func ParsesAsFloat(t TestingT, s string, want, delta float64, opts ...any) float64 {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	got, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		t.Fatalf("got: %q; want: number; error: %v;%s", s, err, cfg.msg())
+		return got
+	}
+	if diff := math.Abs(got - want); !(diff <= delta) {
+		t.Fatalf("got: %q (%s); want: %s ± %v; diff: %v;%s",
+			s, cfg.format(got), cfg.format(want), delta, diff, cfg.msg())
+	}
+	return got
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParses(t *testing.T) {
+	want := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"time":        {check: func(tb TestingT) { ParsesAsTime(tb, "2025-03-01 12:30", "2006-01-02 15:04", want) }},
+		"time offset": {check: func(tb TestingT) { ParsesAsTime(tb, "2025-03-01T13:30:00+01:00", time.RFC3339, want) }},
+		"time mismatch": {
+			check: func(tb TestingT) { ParsesAsTime(tb, "2025-03-01 12:31", "2006-01-02 15:04", want, "created") },
+			msg:   `got: "2025-03-01 12:31" (2025-03-01T12:31:00Z); want: 2025-03-01T12:30:00Z; created`,
+		},
+		"time invalid": {
+			check: func(tb TestingT) { ParsesAsTime(tb, "March 1", "2006-01-02", want) },
+			msg:   `got: "March 1"; want: time in layout "2006-01-02"; error: parsing time "March 1" as "2006-01-02": cannot parse "March 1" as "2006";`,
+		},
+		"float":       {check: func(tb TestingT) { ParsesAsFloat(tb, " 3.14159\n", 3.14, 0.01) }},
+		"float exact": {check: func(tb TestingT) { ParsesAsFloat(tb, "1e3", 1000, 0) }},
+		"float outside": {
+			check: func(tb TestingT) { ParsesAsFloat(tb, "1.5", 1, 0.1) },
+			msg:   `got: "1.5" (1.5); want: 1 ± 0.1; diff: 0.5;`,
+		},
+		"float NaN": {
+			check: func(tb TestingT) { ParsesAsFloat(tb, "NaN", 1, 0.1) },
+			msg:   `got: "NaN" (NaN); want: 1 ± 0.1; diff: NaN;`,
+		},
+		"float invalid": {
+			check: func(tb TestingT) { ParsesAsFloat(tb, "1,5", 1.5, 0) },
+			msg:   `got: "1,5"; want: number; error: strconv.ParseFloat: parsing "1,5": invalid syntax;`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+
+	t.Run("returns parsed", func(t *testing.T) {
+		tb := &mockTB{}
+		if got := ParsesAsFloat(tb, "2.5", 2.5, 0); got != 2.5 {
+			t.Errorf("got: %v; want: 2.5;", got)
+		}
+		if got := ParsesAsTime(tb, "2025-03-01T12:30:00Z", time.RFC3339, want); !got.Equal(want) {
+			t.Errorf("got: %s; want: %s;", got, want)
+		}
+	})
+}
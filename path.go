@@ -0,0 +1,260 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// EqualAt asserts that got and want are equal at the values selected by
+// paths, ignoring everything else. Values are compared as by [Equal] with
+// deep comparison, so options such as [EquateNaNs] apply.
+//
+// A path is a sequence of steps such as "Items[*].Price" or "Meta.Version":
+//   - .Name selects a struct field, or the value at a string map key
+//   - [3] selects a slice or array element, or the value at a map key
+//   - ["a.b"] selects the value at a quoted map key
+//   - [*] selects every element of a slice, array or map
+//
+// Pointers and interfaces are followed implicitly. A leading dot is optional.
+// Elements or keys present in only one of got and want are mismatches.
+//
+// The paths are given as a slice, so that options may follow them:
+//
+//	assert.EqualAt(t, got, want, []string{"Items[*].Price", "Meta.Version"}, assert.EquateNaNs())
+func EqualAt[T any](t TestingT, got, want T, paths []string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(paths) == 0 {
+		t.Fatalf("no paths to compare;%s", cfg.msg())
+		return
+	}
+
+	s := &pathSelector{cmp: &deepComparer{cfg: cfg}}
+	gotV, wantV := addressable(reflect.ValueOf(got)), addressable(reflect.ValueOf(want))
+	for _, p := range paths {
+		steps, err := parsePath(p)
+		if err == nil {
+			err = s.walk("", steps, gotV, wantV)
+		}
+		if err != nil {
+			t.Fatalf("invalid path %q: %v;%s", p, err, cfg.msg())
+			return
+		}
+	}
+
+	if len(s.mismatches) > 0 {
+		t.Fatalf("got and want differ;%s\n  %s", cfg.msg(), strings.Join(limitLines(s.mismatches), "\n  "))
+	}
+}
+
+// pathStep is a single step of a path expression. Exactly one of field,
+// key and wildcard is set.
+type pathStep struct {
+	field    string
+	key      string
+	wildcard bool
+}
+
+// parsePath splits a path expression into its steps.
+func parsePath(p string) ([]pathStep, error) {
+	var steps []pathStep
+	rest := strings.TrimPrefix(p, ".")
+	if rest == "" {
+		return nil, errors.New("empty path")
+	}
+	for len(rest) > 0 {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if strings.HasPrefix(rest, `["`) {
+				// Quoted keys may themselves contain ']'.
+				q, err := strconv.QuotedPrefix(rest[1:])
+				if err != nil || !strings.HasPrefix(rest[1+len(q):], "]") {
+					return nil, fmt.Errorf("unterminated key at %q", rest)
+				}
+				key, _ := strconv.Unquote(q)
+				steps = append(steps, pathStep{key: key})
+				rest = rest[len(q)+2:]
+				continue
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated index at %q", rest)
+			}
+			sel := rest[1:end]
+			switch sel {
+			case "":
+				return nil, fmt.Errorf("empty index at %q", rest)
+			case "*":
+				steps = append(steps, pathStep{wildcard: true})
+			default:
+				steps = append(steps, pathStep{key: sel})
+			}
+			rest = rest[end+1:]
+		case rest[0] == '.' && len(steps) > 0:
+			rest = rest[1:]
+			fallthrough
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty field name at %q", rest)
+			}
+			steps = append(steps, pathStep{field: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+	return steps, nil
+}
+
+// pathSelector compares the values of got and want selected by paths.
+type pathSelector struct {
+	cmp        *deepComparer
+	mismatches []string
+}
+
+func (s *pathSelector) walk(path string, steps []pathStep, got, want reflect.Value) error {
+	got, want = indirect(got), indirect(want)
+	if len(steps) == 0 || !got.IsValid() || !want.IsValid() || got.Type() != want.Type() {
+		s.leaf(path, got, want)
+		return nil
+	}
+
+	step := steps[0]
+	switch {
+	case step.wildcard:
+		switch got.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := range max(got.Len(), want.Len()) {
+				if err := s.walk(fmt.Sprintf("%s[%d]", path, i), steps[1:], elemAt(got, i), elemAt(want, i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		case reflect.Map:
+			keys := got.MapKeys()
+			for _, k := range want.MapKeys() {
+				if !got.MapIndex(k).IsValid() {
+					keys = append(keys, k)
+				}
+			}
+			slices.SortFunc(keys, compareValues)
+			for _, k := range keys {
+				if err := s.walk(fmt.Sprintf("%s[%#v]", path, k), steps[1:], got.MapIndex(k), want.MapIndex(k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return fmt.Errorf("cannot range over %s at %s", got.Type(), displayPath(path))
+	case step.field != "" && got.Kind() == reflect.Struct:
+		sf, ok := got.Type().FieldByName(step.field)
+		if !ok {
+			return fmt.Errorf("no field %s in %s", step.field, got.Type())
+		}
+		return s.walk(path+"."+step.field, steps[1:], got.FieldByIndex(sf.Index), want.FieldByIndex(sf.Index))
+	case step.field != "" && got.Kind() == reflect.Map:
+		return s.walkKey(path, step.field, steps, got, want)
+	case step.key != "" && (got.Kind() == reflect.Slice || got.Kind() == reflect.Array):
+		i, err := strconv.Atoi(step.key)
+		if err != nil || i < 0 {
+			return fmt.Errorf("invalid index [%s] for %s", step.key, got.Type())
+		}
+		g, w := elemAt(got, i), elemAt(want, i)
+		if !g.IsValid() && !w.IsValid() {
+			return nil
+		}
+		return s.walk(fmt.Sprintf("%s[%d]", path, i), steps[1:], g, w)
+	case step.key != "" && got.Kind() == reflect.Map:
+		return s.walkKey(path, step.key, steps, got, want)
+	}
+
+	if step.field != "" {
+		return fmt.Errorf("cannot select field %s of %s at %s", step.field, got.Type(), displayPath(path))
+	}
+	return fmt.Errorf("cannot index %s at %s", got.Type(), displayPath(path))
+}
+
+// walkKey continues a walk at the map key named by key, converted to the
+// key type of got.
+func (s *pathSelector) walkKey(path, key string, steps []pathStep, got, want reflect.Value) error {
+	k, err := mapKey(key, got.Type().Key())
+	if err != nil {
+		return err
+	}
+	g, w := got.MapIndex(k), want.MapIndex(k)
+	if !g.IsValid() && !w.IsValid() {
+		return nil
+	}
+	return s.walk(fmt.Sprintf("%s[%#v]", path, k), steps[1:], g, w)
+}
+
+func (s *pathSelector) leaf(path string, got, want reflect.Value) {
+	if s.cmp.compare(got, want) {
+		return
+	}
+	s.mismatches = append(s.mismatches,
+		fmt.Sprintf("%s: got %s, want %s", displayPath(path), formatValue(got), formatValue(want)))
+}
+
+// mapKey converts a key from a path expression to a map key of type typ.
+func mapKey(key string, typ reflect.Type) (reflect.Value, error) {
+	k := reflect.New(typ).Elem()
+	var err error
+	switch typ.Kind() {
+	case reflect.String:
+		k.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(key, 10, typ.Bits()); err == nil {
+			k.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		if n, err = strconv.ParseUint(key, 10, typ.Bits()); err == nil {
+			k.SetUint(n)
+		}
+	default:
+		return k, fmt.Errorf("unsupported map key type %s", typ)
+	}
+	if err != nil {
+		return k, fmt.Errorf("invalid key %q for %s", key, typ)
+	}
+	return k, nil
+}
+
+// indirect follows non-nil pointers and interfaces.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// elemAt returns the i-th element of v, or the zero Value if out of range.
+func elemAt(v reflect.Value, i int) reflect.Value {
+	if i >= v.Len() {
+		return reflect.Value{}
+	}
+	return v.Index(i)
+}
+
+// displayPath renders a path built by pathSelector for a failure message.
+func displayPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return strings.TrimPrefix(path, ".")
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type pathItem struct {
+	Name  string
+	Price float64
+}
+
+type pathOrder struct {
+	ID    int
+	Items []pathItem
+	Meta  map[string]any
+	Refs  map[int]*pathItem
+	notes string
+}
+
+func TestEqualAt(t *testing.T) {
+	order := func() pathOrder {
+		return pathOrder{
+			ID:    1,
+			Items: []pathItem{{"apple", 1.5}, {"pear", 2}},
+			Meta:  map[string]any{"Version": 3, "a.b": "x"},
+			Refs:  map[int]*pathItem{7: {"plum", 4}},
+			notes: "secret",
+		}
+	}
+	other := order()
+	other.ID = 2
+	other.Items = []pathItem{{"apricot", 1.5}, {"peach", 2}}
+	other.notes = "public"
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"selected paths equal": {check: func(tb TestingT) {
+			EqualAt(tb, order(), other, []string{"Items[*].Price", "Meta.Version", ".Refs[7].Name"})
+		}},
+		"map key": {check: func(tb TestingT) {
+			EqualAt(tb, order(), other, []string{`Meta["a.b"]`, "Meta[*]", "Items[1].Price"})
+		}},
+		"unexported field": {check: func(tb TestingT) {
+			EqualAt(tb, order(), order(), []string{"notes"})
+		}},
+		"index out of range in both": {check: func(tb TestingT) {
+			EqualAt(tb, order(), other, []string{"Items[5].Name"})
+		}},
+		"root wildcard": {check: func(tb TestingT) {
+			EqualAt(tb, []pathItem{{"a", 1}}, []pathItem{{"b", 1}}, []string{"[*].Price"})
+		}},
+		"options": {check: func(tb TestingT) {
+			EqualAt(tb, pathItem{"a", math.NaN()}, pathItem{"b", math.NaN()}, []string{"Price"}, EquateNaNs())
+		}},
+		"differ": {
+			check: func(tb TestingT) {
+				EqualAt(tb, order(), other, []string{"ID", "Items[*].Name", "notes"}, "order")
+			},
+			msg: "got and want differ; order\n" +
+				"  ID: got 1, want 2\n" +
+				`  Items[0].Name: got "apple", want "apricot"` + "\n" +
+				`  Items[1].Name: got "pear", want "peach"` + "\n" +
+				`  notes: got "secret", want "public"`,
+		},
+		"aliased values": {
+			check: func(tb TestingT) {
+				g, w := []int{1}, []int{2}
+				EqualAt(tb, map[int][]int{1: g, 2: g}, map[int][]int{1: w, 2: w}, []string{"[1]", "[2]"})
+			},
+			msg: "got and want differ;\n  [1]: got []int{1}, want []int{2}\n  [2]: got []int{1}, want []int{2}",
+		},
+		"missing element": {
+			check: func(tb TestingT) {
+				EqualAt(tb, []int{1, 2}, []int{1}, []string{"[*]"})
+			},
+			msg: "got and want differ;\n  [1]: got 2, want <missing>",
+		},
+		"missing key": {
+			check: func(tb TestingT) {
+				EqualAt(tb, map[string]int{"a": 1}, map[string]int{"b": 1}, []string{"b"})
+			},
+			msg: `got and want differ;` + "\n" + `  ["b"]: got <missing>, want 1`,
+		},
+		"nil pointer": {
+			check: func(tb TestingT) {
+				EqualAt(tb, &pathItem{"a", 1}, nil, []string{"Price"})
+			},
+			msg: `got and want differ;` + "\n" + `  .: got assert.pathItem{Name:"a", Price:1}, want (*assert.pathItem)(nil)`,
+		},
+		"no paths": {
+			check: func(tb TestingT) { EqualAt(tb, 1, 1, nil) },
+			msg:   "no paths to compare;",
+		},
+		"unknown field": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{"Items[0].Cost"}) },
+			msg:   `invalid path "Items[0].Cost": no field Cost in assert.pathItem;`,
+		},
+		"field of slice": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{"Items.Name"}) },
+			msg:   `invalid path "Items.Name": cannot select field Name of []assert.pathItem at Items;`,
+		},
+		"bad index": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{"Items[x]"}) },
+			msg:   `invalid path "Items[x]": invalid index [x] for []assert.pathItem;`,
+		},
+		"bad key": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{"Refs[x]"}) },
+			msg:   `invalid path "Refs[x]": invalid key "x" for int;`,
+		},
+		"unterminated": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{"Items[0"}) },
+			msg:   `invalid path "Items[0": unterminated index at "[0";`,
+		},
+		"empty path": {
+			check: func(tb TestingT) { EqualAt(tb, order(), order(), []string{""}) },
+			msg:   `invalid path "": empty path;`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	testCases := map[string]struct {
+		path  string
+		steps []pathStep
+	}{
+		"field":    {path: "A", steps: []pathStep{{field: "A"}}},
+		"dotted":   {path: ".A.B", steps: []pathStep{{field: "A"}, {field: "B"}}},
+		"index":    {path: "A[2]", steps: []pathStep{{field: "A"}, {key: "2"}}},
+		"wildcard": {path: "[*].A", steps: []pathStep{{wildcard: true}, {field: "A"}}},
+		"quoted":   {path: `A["x]y"].B`, steps: []pathStep{{field: "A"}, {key: "x]y"}, {field: "B"}}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			steps, err := parsePath(tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(steps, tc.steps) {
+				t.Errorf("got: %+v; want: %+v;", steps, tc.steps)
+			}
+		})
+	}
+}
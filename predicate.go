@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllMatch asserts that pred holds for every element of s, listing the
+// elements it doesn't hold for, with their indices.
+func AllMatch[T any](t TestingT, s []T, pred func(T) bool, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if failing := matchingElements(s, func(v T) bool { return !pred(v) }, cfg); failing != "" {
+		t.Fatalf("got: %s; want: all elements to match; not matching: %s;%s", cfg.format(s), failing, cfg.msg())
+	}
+}
+
+// AnyMatch asserts that pred holds for at least one element of s.
+func AnyMatch[T any](t TestingT, s []T, pred func(T) bool, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	for _, v := range s {
+		if pred(v) {
+			return
+		}
+	}
+	t.Fatalf("got: %s; want: any element to match;%s", cfg.format(s), cfg.msg())
+}
+
+// NoneMatch asserts that pred holds for no element of s, listing the elements
+// it holds for, with their indices.
+func NoneMatch[T any](t TestingT, s []T, pred func(T) bool, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if matching := matchingElements(s, pred, cfg); matching != "" {
+		t.Fatalf("got: %s; want: no elements to match; matching: %s;%s", cfg.format(s), matching, cfg.msg())
+	}
+}
+
+// matchingElements renders the elements of s that pred holds for, as
+// "[index]=value" pairs, or returns "" if there are none.
+func matchingElements[T any](s []T, pred func(T) bool, cfg *config) string {
+	var listed []string
+	count := 0
+	for i, v := range s {
+		if !pred(v) {
+			continue
+		}
+		count++
+		if len(listed) < maxListedMismatches {
+			listed = append(listed, fmt.Sprintf("[%d]=%s", i, cfg.format(v)))
+		}
+	}
+	if count > len(listed) {
+		listed = append(listed, fmt.Sprintf("and %d more", count-len(listed)))
+	}
+	return strings.Join(listed, ", ")
+}
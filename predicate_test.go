@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+func TestPredicates(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"all match":       {check: func(tb TestingT) { AllMatch(tb, []int{1, 2, 3}, positive) }},
+		"all match empty": {check: func(tb TestingT) { AllMatch(tb, nil, positive) }},
+		"not all match": {
+			check: func(tb TestingT) { AllMatch(tb, []int{1, -2, 3, -4}, positive, "signs") },
+			msg:   "got: []int{1, -2, 3, -4}; want: all elements to match; not matching: [1]=-2, [3]=-4; signs",
+		},
+		"not all match many": {
+			check: func(tb TestingT) { AllMatch(tb, make([]int, 12), positive) },
+			msg: "got: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}; want: all elements to match; not matching: " +
+				"[0]=0, [1]=0, [2]=0, [3]=0, [4]=0, [5]=0, [6]=0, [7]=0, [8]=0, [9]=0, and 2 more;",
+		},
+		"any match": {check: func(tb TestingT) { AnyMatch(tb, []int{-1, 2}, positive) }},
+		"none of any match": {
+			check: func(tb TestingT) { AnyMatch(tb, []int{-1, -2}, positive) },
+			msg:   "got: []int{-1, -2}; want: any element to match;",
+		},
+		"any match empty": {
+			check: func(tb TestingT) { AnyMatch(tb, []int{}, positive) },
+			msg:   "got: []int{}; want: any element to match;",
+		},
+		"none match":       {check: func(tb TestingT) { NoneMatch(tb, []int{-1, -2}, positive) }},
+		"none match empty": {check: func(tb TestingT) { NoneMatch(tb, nil, positive) }},
+		"some match": {
+			check: func(tb TestingT) { NoneMatch(tb, []string{"a", "", "b"}, func(s string) bool { return s != "" }) },
+			msg:   `got: []string{"a", "", "b"}; want: no elements to match; matching: [0]="a", [2]="b";`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Severity reports how a recorded [Failure] was raised.
+type Severity int
+
+const (
+	// SeverityError is recorded for failures raised via Error or Errorf.
+	SeverityError Severity = iota + 1
+	// SeverityFatal is recorded for failures raised via Fatal or Fatalf.
+	SeverityFatal
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityFatal:
+		return "fatal"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// ErrFailed is wrapped by every [Failure], so recorded failures can be
+// detected with [errors.Is].
+var ErrFailed = errors.New("assertion failed")
+
+// Failure is a single assertion failure captured by a [RecordingT] or a
+// [ChildT].
+type Failure struct {
+	Severity Severity
+	Message  string
+
+	// File and Line locate the failing assertion, if known.
+	File string `json:",omitempty"`
+	Line int    `json:",omitempty"`
+
+	// Test names the test the failure was raised in, if known.
+	Test string `json:",omitempty"`
+}
+
+func (f Failure) Error() string {
+	return f.Message
+}
+
+func (f Failure) Unwrap() error {
+	return ErrFailed
+}
+
+// RecordingT is a [TestingT] that records failures instead of reporting them,
+// for testing assertions themselves or consuming their outcome in tooling.
+//
+// Unlike [testing.T], Fatal and Fatalf do not stop the calling goroutine.
+// Assertions in this package return right after reporting a failure, so a
+// RecordingT can be passed to them directly.
+type RecordingT struct {
+	mu       sync.Mutex
+	name     string
+	failures []Failure
+}
+
+// NewRecordingT returns a RecordingT that records failures as raised in the
+// test run by parent, if parent has a Name method as [testing.T] does.
+func NewRecordingT(parent TestingT) *RecordingT {
+	return &RecordingT{name: testName(parent)}
+}
+
+func (r *RecordingT) Helper() {}
+
+// Name returns the name of the test set by [NewRecordingT], if any.
+func (r *RecordingT) Name() string {
+	return r.name
+}
+
+func (r *RecordingT) Error(args ...any) {
+	r.record(SeverityError, fmt.Sprint(args...))
+}
+
+func (r *RecordingT) Errorf(format string, args ...any) {
+	r.record(SeverityError, fmt.Sprintf(format, args...))
+}
+
+func (r *RecordingT) Fatal(args ...any) {
+	r.record(SeverityFatal, fmt.Sprint(args...))
+}
+
+func (r *RecordingT) Fatalf(format string, args ...any) {
+	r.record(SeverityFatal, fmt.Sprintf(format, args...))
+}
+
+// Failures returns a copy of the failures recorded so far, in order.
+func (r *RecordingT) Failures() []Failure {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Failure(nil), r.failures...)
+}
+
+// Err returns nil if nothing was recorded, or the recorded failures joined
+// with [errors.Join].
+func (r *RecordingT) Err() error {
+	failures := r.Failures()
+	errs := make([]error, 0, len(failures))
+	for _, f := range failures {
+		errs = append(errs, f)
+	}
+	return errors.Join(errs...)
+}
+
+// Reset discards all recorded failures.
+func (r *RecordingT) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = nil
+}
+
+func (r *RecordingT) record(severity Severity, msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, Failure{Severity: severity, Message: msg, Test: r.name})
+}
+
+// Failed reports whether any failure was recorded by rec.
+func Failed(rec *RecordingT) bool {
+	return len(rec.Failures()) > 0
+}
+
+// FailedFatally reports whether any fatal failure was recorded by rec.
+func FailedFatally(rec *RecordingT) bool {
+	for _, f := range rec.Failures() {
+		if f.Severity == SeverityFatal {
+			return true
+		}
+	}
+	return false
+}
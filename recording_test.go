@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRecordingT(t *testing.T) {
+	t.Run("passing assertion", func(t *testing.T) {
+		rec := &RecordingT{}
+		Equal(rec, 42, 42)
+		if Failed(rec) {
+			t.Errorf("failed: %v", rec.Failures())
+		}
+		if err := rec.Err(); err != nil {
+			t.Errorf("got: %v; want: <nil>;", err)
+		}
+	})
+
+	t.Run("failing assertion", func(t *testing.T) {
+		rec := &RecordingT{}
+		Equal(rec, 42, 84)
+		if !Failed(rec) {
+			t.Error("should have failed")
+		}
+		if !FailedFatally(rec) {
+			t.Error("should be fatal")
+		}
+
+		failures := rec.Failures()
+		if len(failures) != 1 {
+			t.Fatalf("got: %d failures; want: 1;", len(failures))
+		}
+		wantMsg := "got: 42; want: 84;"
+		if failures[0].Message != wantMsg {
+			t.Errorf("got: %q; want: %q;", failures[0].Message, wantMsg)
+		}
+		if failures[0].Severity != SeverityFatal {
+			t.Errorf("got: %v; want: %v;", failures[0].Severity, SeverityFatal)
+		}
+		if !errors.Is(rec.Err(), ErrFailed) {
+			t.Errorf("got: %v; want wrapped ErrFailed", rec.Err())
+		}
+	})
+
+	t.Run("non-fatal failure", func(t *testing.T) {
+		rec := &RecordingT{}
+		rec.Errorf("oops %d", 1)
+		if !Failed(rec) {
+			t.Error("should have failed")
+		}
+		if FailedFatally(rec) {
+			t.Error("should not be fatal")
+		}
+		var f Failure
+		if !errors.As(rec.Err(), &f) || f.Message != "oops 1" {
+			t.Errorf("got: %#v; want message %q", f, "oops 1")
+		}
+	})
+
+	t.Run("test name", func(t *testing.T) {
+		rec := NewRecordingT(t)
+		Equal(rec, 1, 2)
+		if got := rec.Failures()[0].Test; got != t.Name() {
+			t.Errorf("got: %q; want: %q;", got, t.Name())
+		}
+		if rec.Name() != t.Name() {
+			t.Errorf("got: %q; want: %q;", rec.Name(), t.Name())
+		}
+
+		data, err := json.Marshal(rec.Failures()[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantJSON := `{"Severity":2,"Message":"got: 1; want: 2;","Test":"TestRecordingT/test_name"}`
+		if string(data) != wantJSON {
+			t.Errorf("got: %s; want: %s;", data, wantJSON)
+		}
+	})
+
+	t.Run("without name", func(t *testing.T) {
+		rec := NewRecordingT(&RecordingT{})
+		Equal(rec, 1, 2)
+		if got := rec.Failures()[0].Test; got != "" {
+			t.Errorf("got: %q; want: %q;", got, "")
+		}
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		rec := &RecordingT{}
+		True(rec, false)
+		rec.Reset()
+		if Failed(rec) {
+			t.Errorf("failed after reset: %v", rec.Failures())
+		}
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UsageReportEnv names the environment variable that enables tallying of
+// assertion usage. Its value is the report file path, or a directory in which
+// a report named after the test binary is written.
+const UsageReportEnv = "ASSERT_USAGE_REPORT"
+
+// FlakeReportEnv names the environment variable that enables the flake report
+// for polling assertions. Its value is the report file path, or a directory in
+// which a report named after the test binary is written.
+const FlakeReportEnv = "ASSERT_FLAKE_REPORT"
+
+var usage = struct {
+	sync.Mutex
+	path   string
+	counts map[string]int
+}{
+	path:   os.Getenv(UsageReportEnv),
+	counts: map[string]int{},
+}
+
+// countUsage tallies a call of the assertion skip frames above its caller,
+// if usage reporting is enabled.
+func countUsage(skip int) {
+	if usage.path == "" {
+		return
+	}
+
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return
+	}
+	name := assertionName(runtime.FuncForPC(pc).Name())
+
+	usage.Lock()
+	defer usage.Unlock()
+	usage.counts[name]++
+}
+
+// assertionName trims the package path and any type parameters from a
+// function name as reported by the runtime.
+func assertionName(fn string) string {
+	fn = fn[strings.LastIndex(fn, "/")+1:]
+	if i := strings.Index(fn, "."); i >= 0 {
+		fn = fn[i+1:]
+	}
+	if i := strings.Index(fn, "["); i >= 0 {
+		fn = fn[:i] + fn[strings.LastIndex(fn, "]")+1:]
+	}
+	return fn
+}
+
+// WriteUsageReport writes the assertion usage tallied so far to the file named
+// by [UsageReportEnv], one "name<TAB>count" line per assertion. It does
+// nothing if usage reporting is not enabled.
+//
+// Call it from TestMain after m.Run.
+func WriteUsageReport() error {
+	if usage.path == "" {
+		return nil
+	}
+
+	usage.Lock()
+	names := make([]string, 0, len(usage.counts))
+	for name := range usage.counts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s\t%d\n", name, usage.counts[name])
+	}
+	usage.Unlock()
+
+	return writeReport(usage.path, ".usage", b.String())
+}
+
+// writeReport writes content to path, or to a file named after the test
+// binary with the given extension if path is a directory.
+func writeReport(path, ext, content string) error {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		path = filepath.Join(path, filepath.Base(os.Args[0])+ext)
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+// flakeBuckets is the number of buckets polling time is tallied in: four
+// quarters of the timeout, and one for timing out.
+const flakeBuckets = 5
+
+var flakes = struct {
+	sync.Mutex
+	path  string
+	sites map[pollSite]*pollStats
+}{
+	path:  os.Getenv(FlakeReportEnv),
+	sites: map[pollSite]*pollStats{},
+}
+
+// pollSite identifies a polling assertion by its call site.
+type pollSite struct {
+	location  string
+	assertion string
+}
+
+type pollStats struct {
+	calls      int
+	attempts   int
+	maxElapsed time.Duration
+	buckets    [flakeBuckets]int
+}
+
+// recordPolling tallies a run of the polling assertion skip frames above its
+// caller, if the flake report is enabled. timedOut reports whether the run
+// used up its whole budget.
+func recordPolling(skip, attempts int, elapsed, timeout time.Duration, timedOut bool) {
+	if flakes.path == "" {
+		return
+	}
+
+	pcs := make([]uintptr, 2)
+	if runtime.Callers(skip+2, pcs) < 2 {
+		return
+	}
+	frames := runtime.CallersFrames(pcs)
+	fn, _ := frames.Next()
+	caller, _ := frames.Next()
+	site := pollSite{
+		location:  fmt.Sprintf("%s:%d", filepath.Base(caller.File), caller.Line),
+		assertion: assertionName(fn.Function),
+	}
+
+	bucket := flakeBuckets - 1
+	if !timedOut && timeout > 0 {
+		bucket = min(int(4*elapsed/timeout), flakeBuckets-2)
+	}
+
+	flakes.Lock()
+	defer flakes.Unlock()
+	stats := flakes.sites[site]
+	if stats == nil {
+		stats = &pollStats{}
+		flakes.sites[site] = stats
+	}
+	stats.calls++
+	stats.attempts += attempts
+	stats.maxElapsed = max(stats.maxElapsed, elapsed)
+	stats.buckets[bucket]++
+}
+
+// WriteFlakeReport writes the polling statistics gathered so far to the file
+// named by [FlakeReportEnv]. It does nothing if the flake report is not
+// enabled.
+//
+// Each line describes one call site of a polling assertion: how often it ran,
+// the total number of attempts, the slowest run, and how many runs finished
+// within each quarter of their timeout or timed out. Sites with runs in the
+// last quarter barely pass, and are likely to flake under load.
+//
+// Call it from TestMain after m.Run.
+func WriteFlakeReport() error {
+	if flakes.path == "" {
+		return nil
+	}
+
+	flakes.Lock()
+	sites := make([]pollSite, 0, len(flakes.sites))
+	for site := range flakes.sites {
+		sites = append(sites, site)
+	}
+	slices.SortFunc(sites, func(a, b pollSite) int {
+		return cmp.Or(cmp.Compare(a.location, b.location), cmp.Compare(a.assertion, b.assertion))
+	})
+	var b strings.Builder
+	b.WriteString("# site\tassertion\tcalls\tattempts\tmax elapsed\t<25%\t<50%\t<75%\t<100%\ttimeout\n")
+	for _, site := range sites {
+		stats := flakes.sites[site]
+		fmt.Fprintf(&b, "%s\t%s\t%d\t%d\t%s", site.location, site.assertion,
+			stats.calls, stats.attempts, stats.maxElapsed)
+		for _, n := range stats.buckets {
+			fmt.Fprintf(&b, "\t%d", n)
+		}
+		b.WriteByte('\n')
+	}
+	flakes.Unlock()
+
+	return writeReport(flakes.path, ".flakes", b.String())
+}
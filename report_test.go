@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAssertionName(t *testing.T) {
+	testCases := map[string]string{
+		"github.com/dropwhile/assert.Equal[...]":           "Equal",
+		"github.com/dropwhile/assert.True":                 "True",
+		"github.com/dropwhile/assert.(*RecordingT).Errorf": "(*RecordingT).Errorf",
+	}
+	for fn, want := range testCases {
+		t.Run(want, func(t *testing.T) {
+			got := assertionName(fn)
+			if got != want {
+				t.Errorf("got: %q; want: %q;", got, want)
+			}
+		})
+	}
+}
+
+func TestUsageReport(t *testing.T) {
+	dir := t.TempDir()
+
+	usage.Lock()
+	oldPath, oldCounts := usage.path, usage.counts
+	usage.path, usage.counts = dir, map[string]int{}
+	usage.Unlock()
+	t.Cleanup(func() {
+		usage.Lock()
+		usage.path, usage.counts = oldPath, oldCounts
+		usage.Unlock()
+	})
+
+	tb := &mockTB{}
+	Equal(tb, 1, 1)
+	Equal(tb, "a", "a")
+	True(tb, true)
+
+	if err := WriteUsageReport(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.Base(os.Args[0])+".usage"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := "Equal\t2\nTrue\t1\n"
+	if string(data) != want {
+		t.Errorf("got: %q; want: %q;", data, want)
+	}
+}
+
+// fakePoll stands in for a polling assertion when testing the flake report.
+func fakePoll(elapsed time.Duration, timedOut bool) {
+	recordPolling(0, 3, elapsed, 4*time.Second, timedOut)
+}
+
+func TestFlakeReport(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "flakes.txt")
+
+	flakes.Lock()
+	oldPath, oldSites := flakes.path, flakes.sites
+	flakes.path, flakes.sites = path, map[pollSite]*pollStats{}
+	flakes.Unlock()
+	t.Cleanup(func() {
+		flakes.Lock()
+		flakes.path, flakes.sites = oldPath, oldSites
+		flakes.Unlock()
+	})
+
+	runs := []struct {
+		elapsed  time.Duration
+		timedOut bool
+	}{
+		{time.Second / 2, false},
+		{3500 * time.Millisecond, false},
+		{4 * time.Second, true},
+	}
+	for _, run := range runs {
+		fakePoll(run.elapsed, run.timedOut)
+	}
+
+	if err := WriteFlakeReport(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got: %d lines; want: 2;\n%s", len(lines), data)
+	}
+	fields := strings.Split(lines[1], "\t")
+	got := strings.Join(fields[1:], "\t")
+	want := "fakePoll\t3\t9\t4s\t1\t0\t0\t1\t1"
+	if got != want {
+		t.Errorf("got: %q; want: %q;", got, want)
+	}
+	if !strings.HasPrefix(fields[0], "report_test.go:") {
+		t.Errorf("got: %q; want call site in report_test.go", fields[0])
+	}
+}
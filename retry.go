@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Retry runs fn until it reports no failure, at most attempts times, for
+// tests of inherently flaky external dependencies. Each attempt gets a fresh
+// TestingT that collects failures. Like [testing.T], it stops the attempt on
+// Fatal, Fatalf or FailNow, and runs the functions registered with Cleanup
+// when the attempt ends.
+//
+// If every attempt fails, the failures of the last attempt are reported to t,
+// followed by a fatal failure summarizing each attempt. A panic in fn is
+// propagated to the caller.
+func Retry(t TestingT, attempts int, fn func(t TestingT), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	attempts = max(attempts, 1)
+	var summary []string
+	var last []Failure
+	for i := range attempts {
+		rt := &retryT{RecordingT: NewRecordingT(t)}
+		rt.run(fn)
+		last = rt.Failures()
+		if len(last) == 0 {
+			return
+		}
+		line := fmt.Sprintf("attempt %d: %s", i+1, last[0].Message)
+		if len(last) > 1 {
+			line += fmt.Sprintf(" (and %d more)", len(last)-1)
+		}
+		summary = append(summary, line)
+	}
+
+	for _, f := range last {
+		t.Errorf("%s", f.Message)
+	}
+	t.Fatalf("got: %d of %d attempts failed; want: a passing attempt;%s\n  %s",
+		attempts, attempts, cfg.msg(), strings.Join(summary, "\n  "))
+}
+
+// retryT is the TestingT given to each attempt of [Retry], and to the block
+// run by [WithTimeout].
+type retryT struct {
+	*RecordingT
+	exited   bool
+	cleanups []func()
+}
+
+func (rt *retryT) Fatal(args ...any) {
+	rt.RecordingT.Fatal(args...)
+	rt.FailNow()
+}
+
+func (rt *retryT) Fatalf(format string, args ...any) {
+	rt.RecordingT.Fatalf(format, args...)
+	rt.FailNow()
+}
+
+// FailNow stops the attempt. A fatal failure is recorded if none was.
+func (rt *retryT) FailNow() {
+	if !FailedFatally(rt.RecordingT) {
+		rt.record(SeverityFatal, "FailNow called")
+	}
+	rt.exited = true
+	runtime.Goexit()
+}
+
+func (rt *retryT) Cleanup(fn func()) {
+	rt.cleanups = append(rt.cleanups, fn)
+}
+
+// run calls fn with rt on a separate goroutine, so that FailNow can stop it,
+// and re-panics in the caller if fn panics. If fn calls runtime.Goexit other
+// than through rt, as by calling FailNow on an outer test, a fatal failure is
+// recorded.
+func (rt *retryT) run(fn func(t TestingT)) {
+	var panicked, returned bool
+	var value any
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			for i := len(rt.cleanups) - 1; i >= 0; i-- {
+				rt.cleanups[i]()
+			}
+		}()
+		panicked, value = catchPanic(func() { fn(rt) })
+		returned = true
+	}()
+	<-done
+
+	// A deferred recover doesn't stop runtime.Goexit, so catchPanic never
+	// returns when fn calls it, and returned is left unset.
+	switch {
+	case panicked:
+		panic(value)
+	case !returned && !rt.exited:
+		rt.record(SeverityFatal, "got: runtime.Goexit; want: return;")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("passes eventually", func(t *testing.T) {
+		rec := &RecordingT{}
+		calls := 0
+		Retry(rec, 3, func(t TestingT) {
+			calls++
+			Equal(t, calls, 2)
+		})
+		if Failed(rec) {
+			t.Errorf("failed: %v", rec.Failures())
+		}
+		if calls != 2 {
+			t.Errorf("got: %d calls; want: 2;", calls)
+		}
+	})
+
+	t.Run("fatal stops the attempt", func(t *testing.T) {
+		rec := &RecordingT{}
+		calls, after := 0, 0
+		var cleaned []int
+		Retry(rec, 2, func(t TestingT) {
+			calls++
+			t.(cleanupT).Cleanup(func() { cleaned = append(cleaned, calls) })
+			True(t, false, "flaky")
+			after++
+			Equal(t, 1, 2)
+		}, "external")
+
+		if calls != 2 || after != 0 {
+			t.Errorf("got: %d calls, %d past fatal; want: 2 calls, 0 past fatal;", calls, after)
+		}
+		if !slices.Equal(cleaned, []int{1, 2}) {
+			t.Errorf("got: cleanups after %v; want: [1 2];", cleaned)
+		}
+		var msgs []string
+		for _, f := range rec.Failures() {
+			msgs = append(msgs, f.Severity.String()+": "+f.Message)
+		}
+		wantMsgs := []string{
+			"error: got: false; want: true; flaky",
+			"fatal: got: 2 of 2 attempts failed; want: a passing attempt; external\n" +
+				"  attempt 1: got: false; want: true; flaky\n" +
+				"  attempt 2: got: false; want: true; flaky",
+		}
+		if !slices.Equal(msgs, wantMsgs) {
+			t.Errorf("got: %q; want: %q;", msgs, wantMsgs)
+		}
+	})
+
+	t.Run("errors continue", func(t *testing.T) {
+		rec := &RecordingT{}
+		Retry(rec, 1, func(t TestingT) {
+			t.Errorf("first")
+			t.Errorf("second")
+		})
+		wantMsg := "got: 1 of 1 attempts failed; want: a passing attempt;\n  attempt 1: first (and 1 more)"
+		if f := rec.Failures(); len(f) != 3 || f[2].Severity != SeverityFatal || f[2].Message != wantMsg {
+			t.Errorf("got: %v; want: fatal %q;", f, wantMsg)
+		}
+	})
+
+	t.Run("fail now", func(t *testing.T) {
+		rec := &RecordingT{}
+		Retry(rec, 0, func(t TestingT) {
+			t.(failNowT).FailNow()
+		})
+		wantMsg := "got: 1 of 1 attempts failed; want: a passing attempt;\n  attempt 1: FailNow called"
+		if f := rec.Failures(); len(f) != 2 || f[1].Message != wantMsg {
+			t.Errorf("got: %v; want: %q;", f, wantMsg)
+		}
+	})
+
+	t.Run("goexit", func(t *testing.T) {
+		rec := &RecordingT{}
+		Retry(rec, 1, func(t TestingT) { runtime.Goexit() })
+		wantMsg := "got: 1 of 1 attempts failed; want: a passing attempt;\n  attempt 1: got: runtime.Goexit; want: return;"
+		if f := rec.Failures(); len(f) != 2 || f[1].Message != wantMsg {
+			t.Errorf("got: %v; want: %q;", f, wantMsg)
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		rec := &RecordingT{}
+		PanicsWithValue(rec, "boom", func() {
+			Retry(rec, 3, func(t TestingT) { panic("boom") })
+		})
+		if Failed(rec) {
+			t.Errorf("failed: %v", rec.Failures())
+		}
+	})
+}
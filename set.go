@@ -0,0 +1,306 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SetEqual asserts that got and want contain the same elements, ignoring
+// order and duplicates.
+func SetEqual[T comparable](t TestingT, got, want []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	missing := setDifference(want, got)
+	extra := setDifference(got, want)
+	if len(missing) == 0 && len(extra) == 0 {
+		return
+	}
+
+	t.Fatalf("got: %s; want: %s;%s%s",
+		cfg.format(got), cfg.format(want), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+}
+
+// Disjoint asserts that a and b have no elements in common, listing the
+// overlapping values on failure. Elements are compared as by [Equal], so Equal
+// methods are respected.
+func Disjoint[T any](t TestingT, a, b []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if common := commonElements(a, b, cfg); len(common) > 0 {
+		t.Fatalf("got: %s and %s; want: no common elements; common: %s;%s",
+			cfg.format(a), cfg.format(b), cfg.format(common), cfg.msg())
+	}
+}
+
+// Intersects asserts that a and b have at least one element in common.
+// Elements are compared as by [Equal].
+func Intersects[T any](t TestingT, a, b []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(commonElements(a, b, cfg)) == 0 {
+		t.Fatalf("got: %s and %s; want: common elements;%s", cfg.format(a), cfg.format(b), cfg.msg())
+	}
+}
+
+// Overlaps asserts that a and b share at least one element, as the complement
+// of [Disjoint]. It performs the same check as [Intersects], for membership
+// invariants that read better phrased as an overlap.
+func Overlaps[T any](t TestingT, a, b []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if len(commonElements(a, b, cfg)) == 0 {
+		t.Fatalf("got: %s and %s; want: common elements;%s", cfg.format(a), cfg.format(b), cfg.msg())
+	}
+}
+
+// ElementsMatch asserts that got and want contain the same elements,
+// regardless of order. Each element must occur as many times in got as in
+// want. Elements are compared as by [Equal], so Equal methods are respected.
+func ElementsMatch[T any](t TestingT, got, want []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if missing, extra := multisetDiff(got, want, cfg); len(missing) > 0 || len(extra) > 0 {
+		t.Fatalf("got: %s; want: %s in any order;%s%s",
+			cfg.format(got), cfg.format(want), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+	}
+}
+
+// IsPermutationOf asserts that got holds the same elements as want, with the
+// same number of occurrences, in any order.
+func IsPermutationOf[T any](t TestingT, got, want []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if missing, extra := multisetDiff(got, want, cfg); len(missing) > 0 || len(extra) > 0 {
+		t.Fatalf("got: %s; want: permutation of %s;%s%s",
+			cfg.format(got), cfg.format(want), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+	}
+}
+
+// IsShuffled asserts that got is a permutation of original in a different
+// order, for testing randomization without depending on a particular order.
+func IsShuffled[T any](t TestingT, got, original []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if missing, extra := multisetDiff(got, original, cfg); len(missing) > 0 || len(extra) > 0 {
+		t.Fatalf("got: %s; want: shuffle of %s;%s%s",
+			cfg.format(got), cfg.format(original), formatMultisetDiff(missing, extra, cfg), cfg.msg())
+		return
+	}
+	for i := range got {
+		if !isEqual(got[i], original[i], cfg) {
+			return
+		}
+	}
+	t.Fatalf("got: %s; want: different order than original;%s", cfg.format(got), cfg.msg())
+}
+
+// multisetDiff matches the elements of got and want using isEqual, and
+// returns the elements of want without a match in got, and those of got
+// without a match in want.
+func multisetDiff[T any](got, want []T, cfg *config) (missing, extra []T) {
+	matched := make([]bool, len(got))
+	for _, w := range want {
+		found := false
+		for i, g := range got {
+			if !matched[i] && isEqual(g, w, cfg) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, w)
+		}
+	}
+	for i, g := range got {
+		if !matched[i] {
+			extra = append(extra, g)
+		}
+	}
+	return missing, extra
+}
+
+// formatMultisetDiff renders the result of multisetDiff for a failure
+// message.
+func formatMultisetDiff[T any](missing, extra []T, cfg *config) string {
+	var b strings.Builder
+	if len(missing) > 0 {
+		b.WriteString(" missing: " + cfg.format(missing) + ";")
+	}
+	if len(extra) > 0 {
+		b.WriteString(" extra: " + cfg.format(extra) + ";")
+	}
+	return b.String()
+}
+
+// Unique asserts that s has no duplicate elements. Elements are compared as
+// by [Equal].
+func Unique[T any](t TestingT, s []T, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var dups []string
+	seen := make([]bool, len(s))
+	for i := range s {
+		if seen[i] {
+			continue
+		}
+		indices := []int{i}
+		for j := i + 1; j < len(s); j++ {
+			if !seen[j] && isEqual(s[i], s[j], cfg) {
+				seen[j] = true
+				indices = append(indices, j)
+			}
+		}
+		if len(indices) > 1 {
+			dups = append(dups, fmt.Sprintf("%s at %v", cfg.format(s[i]), indices))
+		}
+	}
+	if len(dups) > 0 {
+		t.Fatalf("got: %s; want: unique elements; duplicates: %s;%s",
+			cfg.format(s), strings.Join(dups, ", "), cfg.msg())
+	}
+}
+
+// Subset asserts that every element of sub is also in super. Both must be
+// slices or arrays of the same element type, or maps of the same type. For
+// maps, every key of sub must be in super with an equal value. Elements are
+// compared as by [Equal].
+func Subset(t TestingT, super, sub any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	missing, ok := subsetMissing(super, sub, cfg)
+	switch {
+	case !ok:
+		t.Fatalf("unsupported types: %T and %T;%s", super, sub, cfg.msg())
+	case missing.Len() > 0:
+		t.Fatalf("got: %s; want: superset of %s; missing: %s;%s",
+			cfg.format(super), cfg.format(sub), cfg.format(missing.Interface()), cfg.msg())
+	}
+}
+
+// NotSubset asserts that at least one element of sub is not in super, using
+// the same rules as [Subset].
+func NotSubset(t TestingT, super, sub any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	missing, ok := subsetMissing(super, sub, cfg)
+	switch {
+	case !ok:
+		t.Fatalf("unsupported types: %T and %T;%s", super, sub, cfg.msg())
+	case missing.Len() == 0:
+		t.Fatalf("got: %s; want: not a superset of %s;%s", cfg.format(super), cfg.format(sub), cfg.msg())
+	}
+}
+
+// subsetMissing returns the elements of sub that are not in super, as a
+// slice or a map, and reports whether the types of super and sub are
+// supported.
+func subsetMissing(super, sub any, cfg *config) (reflect.Value, bool) {
+	sv, bv := reflect.ValueOf(super), reflect.ValueOf(sub)
+	if !sv.IsValid() || !bv.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	switch {
+	case isList(sv) && isList(bv) && sv.Type().Elem() == bv.Type().Elem():
+		missing := reflect.MakeSlice(reflect.SliceOf(bv.Type().Elem()), 0, 0)
+		for i := range bv.Len() {
+			want := bv.Index(i)
+			found := false
+			for j := range sv.Len() {
+				if isEqual(sv.Index(j).Interface(), want.Interface(), cfg) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = reflect.Append(missing, want)
+			}
+		}
+		return missing, true
+
+	case sv.Kind() == reflect.Map && sv.Type() == bv.Type():
+		missing := reflect.MakeMap(bv.Type())
+		iter := bv.MapRange()
+		for iter.Next() {
+			got := sv.MapIndex(iter.Key())
+			if !got.IsValid() || !isEqual(got.Interface(), iter.Value().Interface(), cfg) {
+				missing.SetMapIndex(iter.Key(), iter.Value())
+			}
+		}
+		return missing, true
+	}
+	return reflect.Value{}, false
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// setDifference returns the distinct elements of a that are not in b, in
+// order of first appearance.
+func setDifference[T comparable](a, b []T) []T {
+	exclude := make(map[T]bool, len(b))
+	for _, v := range b {
+		exclude[v] = true
+	}
+	var diff []T
+	for _, v := range a {
+		if !exclude[v] {
+			diff = append(diff, v)
+			exclude[v] = true
+		}
+	}
+	return diff
+}
+
+// commonElements returns the distinct elements of a that are also in b, in
+// order of first appearance, comparing elements with isEqual.
+func commonElements[T any](a, b []T, cfg *config) []T {
+	var common []T
+	for _, v := range a {
+		if slices.ContainsFunc(common, func(c T) bool { return isEqual(c, v, cfg) }) {
+			continue
+		}
+		if slices.ContainsFunc(b, func(w T) bool { return isEqual(v, w, cfg) }) {
+			common = append(common, v)
+		}
+	}
+	return common
+}
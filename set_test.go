@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSets(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":            {check: func(tb TestingT) { SetEqual(tb, []int{1, 2, 3}, []int{3, 2, 1}) }},
+		"equal duplicates": {check: func(tb TestingT) { SetEqual(tb, []int{1, 1, 2}, []int{2, 1}) }},
+		"equal empty":      {check: func(tb TestingT) { SetEqual(tb, nil, []string{}) }},
+		"not equal": {
+			check: func(tb TestingT) { SetEqual(tb, []int{1, 2, 4, 4}, []int{3, 1, 2}) },
+			msg:   "got: []int{1, 2, 4, 4}; want: []int{3, 1, 2}; missing: []int{3}; extra: []int{4};",
+		},
+		"missing only": {
+			check: func(tb TestingT) { SetEqual(tb, []string{"a"}, []string{"a", "b"}, "tags") },
+			msg:   `got: []string{"a"}; want: []string{"a", "b"}; missing: []string{"b"}; tags`,
+		},
+		"disjoint":       {check: func(tb TestingT) { Disjoint(tb, []int{1, 2}, []int{3, 4}) }},
+		"disjoint empty": {check: func(tb TestingT) { Disjoint(tb, []int{}, []int{3, 4}) }},
+		"not disjoint": {
+			check: func(tb TestingT) { Disjoint(tb, []int{1, 2, 3, 2}, []int{2, 3, 4}) },
+			msg:   "got: []int{1, 2, 3, 2} and []int{2, 3, 4}; want: no common elements; common: []int{2, 3};",
+		},
+		"not disjoint equaler": {
+			check: func(tb TestingT) { Disjoint(tb, []noisy{{val: 1}, {val: 2}}, []noisy{{val: 2, noise: 7}}) },
+			msg: "got: []assert.noisy{assert.noisy{val:1, noise:0}, assert.noisy{val:2, noise:0}} and []assert.noisy{assert.noisy{val:2, noise:7}}; " +
+				"want: no common elements; common: []assert.noisy{assert.noisy{val:2, noise:0}};",
+		},
+		"disjoint nan": {check: func(tb TestingT) { Disjoint(tb, []float64{math.NaN()}, []float64{math.NaN()}) }},
+		"not disjoint nan": {
+			check: func(tb TestingT) { Disjoint(tb, []float64{math.NaN()}, []float64{math.NaN()}, EquateNaNs()) },
+			msg:   "got: []float64{NaN} and []float64{NaN}; want: no common elements; common: []float64{NaN};",
+		},
+		"intersects equaler": {check: func(tb TestingT) {
+			Intersects(tb, []noisy{newNoisy(1)}, []noisy{{val: 1, noise: 3}})
+		}},
+		"intersects": {check: func(tb TestingT) { Intersects(tb, []int{1, 2}, []int{2, 3}) }},
+		"no intersection": {
+			check: func(tb TestingT) { Intersects(tb, []int{1, 2}, []int{3}) },
+			msg:   "got: []int{1, 2} and []int{3}; want: common elements;",
+		},
+		"overlaps": {check: func(tb TestingT) { Overlaps(tb, []string{"a", "b"}, []string{"b"}) }},
+		"no overlap": {
+			check: func(tb TestingT) { Overlaps(tb, []string{"a"}, []string{"b"}, "roles") },
+			msg:   `got: []string{"a"} and []string{"b"}; want: common elements; roles`,
+		},
+		"permutation":            {check: func(tb TestingT) { IsPermutationOf(tb, []int{3, 1, 2, 1}, []int{1, 1, 2, 3}) }},
+		"permutation same order": {check: func(tb TestingT) { IsPermutationOf(tb, []int{1, 2}, []int{1, 2}) }},
+		"permutation equaler": {check: func(tb TestingT) {
+			IsPermutationOf(tb, []noisy{{val: 1, noise: 5}, {val: 2}}, []noisy{{val: 2, noise: 3}, {val: 1}})
+		}},
+		"not permutation": {
+			check: func(tb TestingT) { IsPermutationOf(tb, []int{1, 2, 2, 4}, []int{1, 1, 2, 3}) },
+			msg:   "got: []int{1, 2, 2, 4}; want: permutation of []int{1, 1, 2, 3}; missing: []int{1, 3}; extra: []int{2, 4};",
+		},
+		"shuffled": {check: func(tb TestingT) { IsShuffled(tb, []int{2, 1, 3}, []int{1, 2, 3}) }},
+		"not shuffled": {
+			check: func(tb TestingT) { IsShuffled(tb, []int{1, 2, 3}, []int{1, 2, 3}, "deck") },
+			msg:   "got: []int{1, 2, 3}; want: different order than original; deck",
+		},
+		"shuffled different elements": {
+			check: func(tb TestingT) { IsShuffled(tb, []int{3, 2}, []int{1, 2, 3}) },
+			msg:   "got: []int{3, 2}; want: shuffle of []int{1, 2, 3}; missing: []int{1};",
+		},
+		"elements match":       {check: func(tb TestingT) { ElementsMatch(tb, []string{"b", "a", "b"}, []string{"b", "b", "a"}) }},
+		"elements match empty": {check: func(tb TestingT) { ElementsMatch(tb, nil, []int{}) }},
+		"elements match equaler": {check: func(tb TestingT) {
+			ElementsMatch(tb, []noisy{newNoisy(1), newNoisy(2)}, []noisy{newNoisy(2), newNoisy(1)})
+		}},
+		"elements missing": {
+			check: func(tb TestingT) { ElementsMatch(tb, []string{"a"}, []string{"a", "b", "a"}) },
+			msg:   `got: []string{"a"}; want: []string{"a", "b", "a"} in any order; missing: []string{"b", "a"};`,
+		},
+		"elements extra": {
+			check: func(tb TestingT) { ElementsMatch(tb, []int{1, 2, 3}, []int{2}, "ids") },
+			msg:   "got: []int{1, 2, 3}; want: []int{2} in any order; extra: []int{1, 3}; ids",
+		},
+		"subset":         {check: func(tb TestingT) { Subset(tb, []int{1, 2, 3}, []int{3, 1}) }},
+		"subset empty":   {check: func(tb TestingT) { Subset(tb, []int{1}, []int(nil)) }},
+		"subset array":   {check: func(tb TestingT) { Subset(tb, [3]string{"a", "b", "c"}, []string{"b"}) }},
+		"subset equaler": {check: func(tb TestingT) { Subset(tb, []noisy{newNoisy(1), newNoisy(2)}, []noisy{newNoisy(2)}) }},
+		"subset map":     {check: func(tb TestingT) { Subset(tb, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2}) }},
+		"subset map miss": {
+			check: func(tb TestingT) { Subset(tb, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4}) },
+			msg:   `got: map[string]int{"a":1, "b":2}; want: superset of map[string]int{"b":3, "c":4}; missing: map[string]int{"b":3, "c":4};`,
+		},
+		"not subset": {
+			check: func(tb TestingT) { Subset(tb, []int{1, 2, 3}, []int{4, 1, 5}, "ids") },
+			msg:   "got: []int{1, 2, 3}; want: superset of []int{4, 1, 5}; missing: []int{4, 5}; ids",
+		},
+		"subset unsupported": {
+			check: func(tb TestingT) { Subset(tb, []int{1}, []string{"1"}, "ids") },
+			msg:   "unsupported types: []int and []string; ids",
+		},
+		"subset nil": {
+			check: func(tb TestingT) { Subset(tb, nil, []int{1}) },
+			msg:   "unsupported types: <nil> and []int;",
+		},
+		"NotSubset unsupported": {
+			check: func(tb TestingT) { NotSubset(tb, 1, []int{1}, "ids") },
+			msg:   "unsupported types: int and []int; ids",
+		},
+		"NotSubset":     {check: func(tb TestingT) { NotSubset(tb, []int{1, 2}, []int{2, 3}) }},
+		"NotSubset map": {check: func(tb TestingT) { NotSubset(tb, map[int]bool{1: true}, map[int]bool{1: false}) }},
+		"NotSubset fails": {
+			check: func(tb TestingT) { NotSubset(tb, []int{1, 2}, []int{2}) },
+			msg:   "got: []int{1, 2}; want: not a superset of []int{2};",
+		},
+		"unique":       {check: func(tb TestingT) { Unique(tb, []string{"a", "b", "c"}) }},
+		"unique empty": {check: func(tb TestingT) { Unique(tb, []int(nil)) }},
+		"not unique": {
+			check: func(tb TestingT) { Unique(tb, []int{1, 2, 1, 3, 2, 1}, "ids") },
+			msg:   "got: []int{1, 2, 1, 3, 2, 1}; want: unique elements; duplicates: 1 at [0 2 5], 2 at [1 4]; ids",
+		},
+		"not unique equaler": {
+			check: func(tb TestingT) { Unique(tb, []noisy{{val: 1, noise: 1}, {val: 1, noise: 2}}) },
+			msg:   "got: []assert.noisy{assert.noisy{val:1, noise:1}, assert.noisy{val:1, noise:2}}; want: unique elements; duplicates: assert.noisy{val:1, noise:1} at [0 1];",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxListedMismatches bounds the number of mismatches listed in a failure.
+const maxListedMismatches = 10
+
+// limitLines truncates lines to maxListedMismatches, noting how many more
+// there were.
+func limitLines(lines []string) []string {
+	if len(lines) <= maxListedMismatches {
+		return lines
+	}
+	return append(lines[:maxListedMismatches:maxListedMismatches],
+		fmt.Sprintf("and %d more", len(lines)-maxListedMismatches))
+}
+
+// Similarity asserts that at least a minScore fraction of the leaf values of
+// got and want match, where leaves are the scalar fields and elements reached
+// by walking structs, slices, arrays and maps. Elements or keys present in
+// only one of the values count as mismatches. On failure the mismatched
+// leaves are listed by path.
+//
+// Similarity is experimental, and its scoring may change.
+func Similarity[T any](t TestingT, got, want T, minScore float64, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	s := &scorer{cmp: &deepComparer{cfg: cfg}, seen: map[visit]bool{}}
+	s.walk("", reflect.ValueOf(got), reflect.ValueOf(want))
+
+	score := 1.0
+	if s.total > 0 {
+		score = float64(s.matched) / float64(s.total)
+	}
+	if score >= minScore {
+		return
+	}
+
+	listed := s.mismatches
+	more := ""
+	if len(listed) > maxListedMismatches {
+		more = fmt.Sprintf("; and %d more", len(listed)-maxListedMismatches)
+		listed = listed[:maxListedMismatches]
+	}
+	t.Fatalf("got: similarity %.3f (%d of %d match); want >= %v; mismatches: %s%s;%s",
+		score, s.matched, s.total, minScore, strings.Join(listed, "; "), more, cfg.msg())
+}
+
+// scorer counts matching leaves of two values.
+type scorer struct {
+	cmp        *deepComparer
+	seen       map[visit]bool
+	matched    int
+	total      int
+	mismatches []string
+}
+
+func (s *scorer) walk(path string, got, want reflect.Value) {
+	if !got.IsValid() || !want.IsValid() || got.Type() != want.Type() {
+		s.leaf(path, got, want)
+		return
+	}
+
+	switch got.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if got.IsNil() || want.IsNil() {
+			s.leaf(path, got, want)
+			return
+		}
+		if got.Kind() == reflect.Pointer {
+			v := visit{a: got.Pointer(), b: want.Pointer(), typ: got.Type()}
+			if s.seen[v] {
+				return
+			}
+			s.seen[v] = true
+		}
+		s.walk(path, got.Elem(), want.Elem())
+	case reflect.Struct:
+		if got.Type() == reflect.TypeFor[time.Time]() || got.NumField() == 0 {
+			s.leaf(path, got, want)
+			return
+		}
+		for i := range got.NumField() {
+			s.walk(path+"."+got.Type().Field(i).Name, got.Field(i), want.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range max(got.Len(), want.Len()) {
+			var g, w reflect.Value
+			if i < got.Len() {
+				g = got.Index(i)
+			}
+			if i < want.Len() {
+				w = want.Index(i)
+			}
+			s.walk(fmt.Sprintf("%s[%d]", path, i), g, w)
+		}
+	case reflect.Map:
+		keys := got.MapKeys()
+		for _, k := range want.MapKeys() {
+			if !got.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		slices.SortFunc(keys, compareValues)
+		for _, k := range keys {
+			s.walk(fmt.Sprintf("%s[%#v]", path, k), got.MapIndex(k), want.MapIndex(k))
+		}
+	default:
+		s.leaf(path, got, want)
+	}
+}
+
+func (s *scorer) leaf(path string, got, want reflect.Value) {
+	s.total++
+	if s.cmp.compare(got, want) {
+		s.matched++
+		return
+	}
+	if path == "" {
+		path = "."
+	}
+	s.mismatches = append(s.mismatches, fmt.Sprintf("%s: got %s, want %s", path, formatValue(got), formatValue(want)))
+}
+
+// formatValue renders a possibly invalid or unexported value.
+func formatValue(v reflect.Value) string {
+	switch {
+	case !v.IsValid():
+		return "<missing>"
+	case v.CanInterface():
+		return fmt.Sprintf("%#v", v.Interface())
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
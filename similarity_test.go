@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+// record is a struct with nested collections.
+type record struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+}
+
+func TestSimilarity(t *testing.T) {
+	want := record{
+		Name:  "gopher",
+		Tags:  []string{"a", "b", "c"},
+		Attrs: map[string]int{"x": 1, "y": 2},
+	}
+
+	t.Run("identical", func(t *testing.T) {
+		tb := &mockTB{}
+		Similarity(tb, want, want, 1)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("mostly equal", func(t *testing.T) {
+		got := record{
+			Name:  "gopher",
+			Tags:  []string{"a", "b", "x"},
+			Attrs: map[string]int{"x": 1, "y": 2},
+		}
+		tb := &mockTB{}
+		Similarity(tb, got, want, 0.8)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		got := record{
+			Name:  "gerbil",
+			Tags:  []string{"a"},
+			Attrs: map[string]int{"x": 1, "z": 3},
+		}
+		tb := &mockTB{}
+		Similarity(tb, got, want, 0.8)
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: similarity 0.286 (2 of 7 match); want >= 0.8; mismatches: ` +
+			`.Name: got "gerbil", want "gopher"; .Tags[1]: got <missing>, want "b"; .Tags[2]: got <missing>, want "c"; ` +
+			`.Attrs["y"]: got <missing>, want 2; .Attrs["z"]: got 3, want <missing>;`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("scalars", func(t *testing.T) {
+		tb := &mockTB{}
+		Similarity(tb, 1, 2, 0.5)
+		wantMsg := "got: similarity 0.000 (0 of 1 match); want >= 0.5; mismatches: .: got 1, want 2;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// BufferEqual asserts that the rendered content of got, such as a
+// [*bytes.Buffer] or [*strings.Builder], equals want. Multi-line content is
+// reported as a line diff.
+func BufferEqual(t TestingT, got fmt.Stringer, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if s := got.String(); s != want {
+		t.Fatalf("%s", textMismatch(s, want, cfg))
+	}
+}
+
+// BufferContains asserts that the rendered content of got, such as a
+// [*bytes.Buffer] or [*strings.Builder], contains want.
+func BufferContains(t TestingT, got fmt.Stringer, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if s := got.String(); !strings.Contains(s, want) {
+		t.Fatalf("got: %q; want to contain %q;%s", s, want, cfg.msg())
+	}
+}
+
+// ContainsAll asserts that s contains every one of substrings, listing the
+// missing ones on failure:
+//
+//	assert.ContainsAll(t, log.String(), []string{"listening", "ready"}, "startup")
+func ContainsAll(t TestingT, s string, substrings []string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	var missing []string
+	for _, sub := range substrings {
+		if !strings.Contains(s, sub) {
+			missing = append(missing, sub)
+		}
+	}
+	if len(missing) > 0 {
+		t.Fatalf("got: %q; want to contain all of %q; missing: %q;%s", s, substrings, missing, cfg.msg())
+	}
+}
+
+// ContainsAny asserts that s contains at least one of substrings, given as a
+// slice as for [ContainsAll].
+func ContainsAny(t TestingT, s string, substrings []string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return
+		}
+	}
+	t.Fatalf("got: %q; want to contain any of %q;%s", s, substrings, cfg.msg())
+}
+
+// MatchesGlob asserts that got matches the glob pattern, with the syntax of
+// [path.Match] applied to each '/' separated element. An element of "**"
+// matches any number of elements, including none, so "a/**/*.go" matches
+// "a/x.go" and "a/b/c/x.go".
+func MatchesGlob(t TestingT, got, pattern string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	matched, err := matchGlob(strings.Split(pattern, "/"), strings.Split(got, "/"))
+	if err != nil {
+		t.Fatalf("unable to parse glob pattern %s: %s", pattern, err)
+		return
+	}
+	if !matched {
+		t.Fatalf("got: %q; want to match glob %q;%s", got, pattern, cfg.msg())
+	}
+}
+
+// matchGlob matches the elements of a path against the elements of a glob
+// pattern. The whole pattern is validated even if it can't match.
+func matchGlob(pattern, elems []string) (bool, error) {
+	for i, p := range pattern {
+		if p == "**" {
+			rest := pattern[i+1:]
+			// Collapse repeated "**", which match nothing more.
+			for len(rest) > 0 && rest[0] == "**" {
+				rest = rest[1:]
+			}
+			for j := i; j <= len(elems); j++ {
+				if ok, err := matchGlob(rest, elems[j:]); ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+		if i >= len(elems) {
+			return false, validateGlob(pattern[i:])
+		}
+		if ok, err := path.Match(p, elems[i]); !ok || err != nil {
+			if err == nil {
+				err = validateGlob(pattern[i+1:])
+			}
+			return false, err
+		}
+	}
+	return len(pattern) == len(elems), nil
+}
+
+// validateGlob reports whether any element of pattern is malformed.
+func validateGlob(pattern []string) error {
+	for _, p := range pattern {
+		if _, err := path.Match(p, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidUTF8 asserts that s is valid UTF-8. On failure the byte offset of the
+// first invalid sequence is reported, with a hex dump of the bytes around it.
+func ValidUTF8(t TestingT, s string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if failure := invalidUTF8([]byte(s)); failure != "" {
+		t.Fatalf("%s%s", failure, cfg.msg())
+	}
+}
+
+// ValidUTF8Bytes is like [ValidUTF8], for a byte slice.
+func ValidUTF8Bytes(t TestingT, b []byte, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if failure := invalidUTF8(b); failure != "" {
+		t.Fatalf("%s%s", failure, cfg.msg())
+	}
+}
+
+// utf8Context is the number of bytes dumped on each side of an invalid UTF-8
+// sequence.
+const utf8Context = 8
+
+// invalidUTF8 renders a failure message for the first invalid sequence in b,
+// or returns "" if b is valid UTF-8.
+func invalidUTF8(b []byte) string {
+	for off := 0; off < len(b); {
+		r, size := utf8.DecodeRune(b[off:])
+		if r != utf8.RuneError || size != 1 {
+			off += size
+			continue
+		}
+
+		var dump strings.Builder
+		for i := max(0, off-utf8Context); i < min(len(b), off+utf8Context+1); i++ {
+			if i > max(0, off-utf8Context) {
+				dump.WriteByte(' ')
+			}
+			if i == off {
+				fmt.Fprintf(&dump, "[%02x]", b[i])
+			} else {
+				fmt.Fprintf(&dump, "%02x", b[i])
+			}
+		}
+		return fmt.Sprintf("got: invalid UTF-8 at byte %d of %d; bytes: %s;", off, len(b), dump.String())
+	}
+	return ""
+}
+
+// EqualFold asserts that got and want are equal under Unicode case folding,
+// as reported by [strings.EqualFold].
+func EqualFold(t TestingT, got, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !strings.EqualFold(got, want) {
+		t.Fatalf("got: %q; want: %q (ignoring case);%s", got, want, cfg.msg())
+	}
+}
+
+// LinesEqual asserts that got and want have the same lines, treating "\r\n"
+// line endings as "\n". The first differing line is reported by number, with
+// lines counted from 1.
+func LinesEqual(t TestingT, got, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	gotLines := strings.Split(strings.ReplaceAll(got, "\r\n", "\n"), "\n")
+	wantLines := strings.Split(strings.ReplaceAll(want, "\r\n", "\n"), "\n")
+	for i := range max(len(gotLines), len(wantLines)) {
+		g, w := "<missing>", "<missing>"
+		if i < len(gotLines) {
+			g = strconv.Quote(gotLines[i])
+		}
+		if i < len(wantLines) {
+			w = strconv.Quote(wantLines[i])
+		}
+		if g != w {
+			t.Fatalf("line %d: got: %s; want: %s;%s", i+1, g, w, cfg.msg())
+			return
+		}
+	}
+}
+
+// EqualNormalized asserts that got and want are equal after trimming leading
+// and trailing whitespace and collapsing each run of whitespace to a single
+// space. The normalized strings are shown on failure.
+func EqualNormalized(t TestingT, got, want string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if g, w := normalizeSpace(got), normalizeSpace(want); g != w {
+		t.Fatalf("got: %q; want: %q (normalized whitespace);%s", g, w, cfg.msg())
+	}
+}
+
+// normalizeSpace trims s and collapses its runs of whitespace to a single
+// space.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// HasPrefix asserts that s begins with prefix. If s is long, only its head is
+// shown on failure.
+func HasPrefix(t TestingT, s, prefix string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !strings.HasPrefix(s, prefix) {
+		t.Fatalf("got: %s; want prefix: %q;%s", head(s, len(prefix)+excerptContext), prefix, cfg.msg())
+	}
+}
+
+// HasSuffix asserts that s ends with suffix. If s is long, only its tail is
+// shown on failure.
+func HasSuffix(t TestingT, s, suffix string, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !strings.HasSuffix(s, suffix) {
+		t.Fatalf("got: %s; want suffix: %q;%s", tail(s, len(suffix)+excerptContext), suffix, cfg.msg())
+	}
+}
+
+// excerptContext is the number of bytes shown beyond the length of the
+// expected prefix or suffix when excerpting a long string.
+const excerptContext = 16
+
+// head renders about the first n bytes of s, noting the full length if s
+// is cut.
+func head(s string, n int) string {
+	if len(s) <= n {
+		return fmt.Sprintf("%q", s)
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return fmt.Sprintf("%q... (%d bytes)", s[:n], len(s))
+}
+
+// tail renders about the last n bytes of s, noting the full length if s
+// is cut.
+func tail(s string, n int) string {
+	if len(s) <= n {
+		return fmt.Sprintf("%q", s)
+	}
+	i := len(s) - n
+	for i < len(s) && !utf8.RuneStart(s[i]) {
+		i++
+	}
+	return fmt.Sprintf("...%q (%d bytes)", s[i:], len(s))
+}
+
+// textMismatch renders a failure message for two differing strings, as a
+// line diff if either spans multiple lines.
+func textMismatch(got, want string, cfg *config) string {
+	if !strings.Contains(got, "\n") && !strings.Contains(want, "\n") {
+		return fmt.Sprintf("got: %q; want: %q;%s", got, want, cfg.msg())
+	}
+	return fmt.Sprintf("got and want differ;%s\n%s", cfg.msg(), renderDiff(got, want))
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBufferEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tb := &mockTB{}
+		buf := bytes.NewBufferString("hello")
+		BufferEqual(tb, buf, "hello")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("single line", func(t *testing.T) {
+		tb := &mockTB{}
+		var sb strings.Builder
+		sb.WriteString("hello")
+		BufferEqual(tb, &sb, "world")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: "hello"; want: "world";`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("multi line", func(t *testing.T) {
+		tb := &mockTB{}
+		buf := bytes.NewBufferString("a\nb\n")
+		BufferEqual(tb, buf, "a\nc\n")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got and want differ;\n--- got\n+++ want\n  a\n- b\n+ c\n  "
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestBufferContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		tb := &mockTB{}
+		BufferContains(tb, bytes.NewBufferString("the night is dark"), "night")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("does not contain", func(t *testing.T) {
+		tb := &mockTB{}
+		BufferContains(tb, bytes.NewBufferString("the night is dark"), "day")
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := `got: "the night is dark"; want to contain "day";`
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+}
+
+func TestContainsAllAny(t *testing.T) {
+	const logs = "level=info msg=started\nlevel=error msg=failed"
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"all":      {check: func(tb TestingT) { ContainsAll(tb, logs, []string{"started", "level=error"}) }},
+		"all none": {check: func(tb TestingT) { ContainsAll(tb, logs, nil) }},
+		"not all": {
+			check: func(tb TestingT) { ContainsAll(tb, "abc", []string{"a", "x", "c", "y"}, "logs") },
+			msg:   `got: "abc"; want to contain all of ["a" "x" "c" "y"]; missing: ["x" "y"]; logs`,
+		},
+		"any": {check: func(tb TestingT) { ContainsAny(tb, logs, []string{"panic", "msg=failed"}) }},
+		"not any": {
+			check: func(tb TestingT) { ContainsAny(tb, "abc", []string{"x", "y"}) },
+			msg:   `got: "abc"; want to contain any of ["x" "y"];`,
+		},
+		"any none": {
+			check: func(tb TestingT) { ContainsAny(tb, "abc", nil) },
+			msg:   `got: "abc"; want to contain any of [];`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestMatchesGlob(t *testing.T) {
+	testCases := map[string]struct {
+		got, pattern string
+		msg          string
+	}{
+		"literal":          {got: "a/b.go", pattern: "a/b.go"},
+		"star":             {got: "a/b.go", pattern: "a/*.go"},
+		"class":            {got: "events.v2", pattern: "events.v[0-9]"},
+		"double star":      {got: "a/b/c/x.go", pattern: "a/**/*.go"},
+		"double star none": {got: "a/x.go", pattern: "a/**/*.go"},
+		"double star end":  {got: "a/b/c", pattern: "a/**"},
+		"double star only": {got: "x/y", pattern: "**"},
+		"repeated":         {got: "a/b", pattern: "**/**/b"},
+		"star one element": {
+			got: "a/b/x.go", pattern: "a/*.go",
+			msg: `got: "a/b/x.go"; want to match glob "a/*.go";`,
+		},
+		"too short": {
+			got: "a", pattern: "a/*",
+			msg: `got: "a"; want to match glob "a/*";`,
+		},
+		"double star no match": {
+			got: "a/b/x.txt", pattern: "a/**/*.go",
+			msg: `got: "a/b/x.txt"; want to match glob "a/**/*.go";`,
+		},
+		"bad pattern": {
+			got: "a/b", pattern: "a/[",
+			msg: "unable to parse glob pattern a/[: syntax error in pattern",
+		},
+		"bad pattern after mismatch": {
+			got: "x/b", pattern: "a/**/[",
+			msg: "unable to parse glob pattern a/**/[: syntax error in pattern",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			MatchesGlob(tb, tc.got, tc.pattern)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestValidUTF8(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"ascii":     {check: func(tb TestingT) { ValidUTF8(tb, "hello") }},
+		"multibyte": {check: func(tb TestingT) { ValidUTF8(tb, "héllo, 世界") }},
+		"empty":     {check: func(tb TestingT) { ValidUTF8Bytes(tb, nil) }},
+		"invalid": {
+			check: func(tb TestingT) { ValidUTF8(tb, "hello\xffworld", "decoder") },
+			msg:   "got: invalid UTF-8 at byte 5 of 11; bytes: 68 65 6c 6c 6f [ff] 77 6f 72 6c 64; decoder",
+		},
+		"truncated": {
+			check: func(tb TestingT) { ValidUTF8Bytes(tb, []byte("0123456789abcdef\xe4\xb8")) },
+			msg:   "got: invalid UTF-8 at byte 16 of 18; bytes: 38 39 61 62 63 64 65 66 [e4] b8;",
+		},
+		"surrogate": {
+			check: func(tb TestingT) { ValidUTF8Bytes(tb, []byte{0xed, 0xa0, 0x80}) },
+			msg:   "got: invalid UTF-8 at byte 0 of 3; bytes: [ed] a0 80;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	testCases := map[string]struct {
+		got, want string
+		msg       string
+	}{
+		"same":    {got: "Content-Type", want: "Content-Type"},
+		"case":    {got: "content-type", want: "Content-Type"},
+		"unicode": {got: "STRASSE", want: "straße", msg: `got: "STRASSE"; want: "straße" (ignoring case);`},
+		"greek":   {got: "ΣΊΣΥΦΟΣ", want: "σίσυφος"},
+		"differ":  {got: "example.com", want: "example.org", msg: `got: "example.com"; want: "example.org" (ignoring case);`},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			EqualFold(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestLinesEqual(t *testing.T) {
+	testCases := map[string]struct {
+		got, want string
+		msg       string
+	}{
+		"same":  {got: "a\nb\n", want: "a\nb\n"},
+		"crlf":  {got: "a\r\nb\r\n", want: "a\nb\n"},
+		"empty": {got: "", want: ""},
+		"differ": {
+			got: "a\r\nb\r\nc", want: "a\nB\nc",
+			msg: `line 2: got: "b"; want: "B";`,
+		},
+		"extra line": {
+			got: "a\nb", want: "a",
+			msg: `line 2: got: "b"; want: <missing>;`,
+		},
+		"trailing newline": {
+			got: "a", want: "a\n",
+			msg: `line 2: got: <missing>; want: "";`,
+		},
+		"lone cr": {
+			got: "a\r", want: "a",
+			msg: `line 1: got: "a\r"; want: "a";`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			LinesEqual(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestEqualNormalized(t *testing.T) {
+	testCases := map[string]struct {
+		got, want string
+		msg       string
+	}{
+		"same":  {got: "select a from t", want: "select a from t"},
+		"runs":  {got: "\n  SELECT a,\n\tb\n  FROM t\n", want: "SELECT a, b FROM t"},
+		"empty": {got: " \n\t", want: ""},
+		"differ": {
+			got: "SELECT  a\nFROM t", want: "SELECT b FROM t",
+			msg: `got: "SELECT a FROM t"; want: "SELECT b FROM t" (normalized whitespace);`,
+		},
+		"space is not removed": {
+			got: "a,b", want: "a, b",
+			msg: `got: "a,b"; want: "a, b" (normalized whitespace);`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			EqualNormalized(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestHasPrefixSuffix(t *testing.T) {
+	long := strings.Repeat("abcdefghij", 10)
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"prefix":       {check: func(tb TestingT) { HasPrefix(tb, "hello world", "hello") }},
+		"empty prefix": {check: func(tb TestingT) { HasPrefix(tb, "hello", "") }},
+		"no prefix": {
+			check: func(tb TestingT) { HasPrefix(tb, "hello world", "world", "greeting") },
+			msg:   `got: "hello world"; want prefix: "world"; greeting`,
+		},
+		"no prefix long": {
+			check: func(tb TestingT) { HasPrefix(tb, long, "xyz") },
+			msg:   `got: "abcdefghijabcdefghi"... (100 bytes); want prefix: "xyz";`,
+		},
+		"no prefix multibyte": {
+			check: func(tb TestingT) { HasPrefix(tb, strings.Repeat("é", 20), "e") },
+			msg:   `got: "éééééééé"... (40 bytes); want prefix: "e";`,
+		},
+		"suffix": {check: func(tb TestingT) { HasSuffix(tb, "hello world", "world") }},
+		"no suffix": {
+			check: func(tb TestingT) { HasSuffix(tb, "hello world", "hello") },
+			msg:   `got: "hello world"; want suffix: "hello";`,
+		},
+		"no suffix long": {
+			check: func(tb TestingT) { HasSuffix(tb, long, "xyz") },
+			msg:   `got: ..."bcdefghijabcdefghij" (100 bytes); want suffix: "xyz";`,
+		},
+		"no suffix multibyte": {
+			check: func(tb TestingT) { HasSuffix(tb, strings.Repeat("é", 20), "e") },
+			msg:   `got: ..."éééééééé" (40 bytes); want suffix: "e";`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"time"
+)
+
+// WithinDuration asserts that got and want are at most tolerance apart.
+func WithinDuration(t TestingT, got, want time.Time, tolerance time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if delta := got.Sub(want).Abs(); delta > tolerance {
+		t.Fatalf("got: %s; want: %s ± %s; delta: %s;%s",
+			got.Format(time.RFC3339Nano), want.Format(time.RFC3339Nano), tolerance, delta, cfg.msg())
+	}
+}
+
+// TimeBefore asserts that got is strictly before bound.
+func TimeBefore(t TestingT, got, bound time.Time, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !got.Before(bound) {
+		t.Fatalf("got: %s; want: before %s; got is %s after;%s",
+			got.Format(time.RFC3339Nano), bound.Format(time.RFC3339Nano), got.Sub(bound), cfg.msg())
+	}
+}
+
+// TimeAfter asserts that got is strictly after bound.
+func TimeAfter(t TestingT, got, bound time.Time, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if !got.After(bound) {
+		t.Fatalf("got: %s; want: after %s; got is %s before;%s",
+			got.Format(time.RFC3339Nano), bound.Format(time.RFC3339Nano), bound.Sub(got), cfg.msg())
+	}
+}
+
+// DurationInDelta asserts that got is at most tolerance away from want.
+func DurationInDelta(t TestingT, got, want, tolerance time.Duration, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if diff := (got - want).Abs(); diff > tolerance.Abs() {
+		t.Fatalf("got: %s; want: %s ± %s; diff: %s;%s", got, want, tolerance, diff, cfg.msg())
+	}
+}
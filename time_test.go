@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithinDuration(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal":  {check: func(tb TestingT) { WithinDuration(tb, base, base, 0) }},
+		"before": {check: func(tb TestingT) { WithinDuration(tb, base.Add(-time.Second), base, time.Second) }},
+		"after":  {check: func(tb TestingT) { WithinDuration(tb, base.Add(time.Second), base, time.Second) }},
+		"zones": {check: func(tb TestingT) {
+			WithinDuration(tb, base.In(time.FixedZone("UTC+5", 5*3600)), base, 0)
+		}},
+		"outside": {
+			check: func(tb TestingT) { WithinDuration(tb, base.Add(1500*time.Millisecond), base, time.Second) },
+			msg:   "got: 2025-01-01T00:00:01.5Z; want: 2025-01-01T00:00:00Z ± 1s; delta: 1.5s;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestTimeBeforeAfter(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"before":       {check: func(tb TestingT) { TimeBefore(tb, base, base.Add(time.Nanosecond)) }},
+		"after":        {check: func(tb TestingT) { TimeAfter(tb, base.Add(time.Nanosecond), base) }},
+		"before zones": {check: func(tb TestingT) { TimeBefore(tb, base.In(time.FixedZone("UTC+5", 5*3600)), base.Add(time.Second)) }},
+		"not before": {
+			check: func(tb TestingT) { TimeBefore(tb, base.Add(90*time.Second), base) },
+			msg:   "got: 2025-01-01T00:01:30Z; want: before 2025-01-01T00:00:00Z; got is 1m30s after;",
+		},
+		"before equal": {
+			check: func(tb TestingT) { TimeBefore(tb, base, base) },
+			msg:   "got: 2025-01-01T00:00:00Z; want: before 2025-01-01T00:00:00Z; got is 0s after;",
+		},
+		"not after": {
+			check: func(tb TestingT) { TimeAfter(tb, base, base.Add(time.Millisecond), "updatedAt") },
+			msg:   "got: 2025-01-01T00:00:00Z; want: after 2025-01-01T00:00:00.001Z; got is 1ms before; updatedAt",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestDurationInDelta(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"equal": {check: func(tb TestingT) { DurationInDelta(tb, time.Second, time.Second, 0) }},
+		"above": {check: func(tb TestingT) { DurationInDelta(tb, 1100*time.Millisecond, time.Second, 100*time.Millisecond) }},
+		"below": {check: func(tb TestingT) { DurationInDelta(tb, 900*time.Millisecond, time.Second, 100*time.Millisecond) }},
+		"outside": {
+			check: func(tb TestingT) { DurationInDelta(tb, 1200*time.Millisecond, time.Second, 100*time.Millisecond) },
+			msg:   "got: 1.2s; want: 1s ± 100ms; diff: 200ms;",
+		},
+		"negative": {
+			check: func(tb TestingT) { DurationInDelta(tb, -time.Second, time.Second, time.Second, "backoff") },
+			msg:   "got: -1s; want: 1s ± 1s; diff: 2s; backoff",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"runtime"
+	"strings"
+	"time"
+)
+
+// WithTimeout runs fn and fails if it does not return within d, so that a
+// hang surfaces as an assertion failure rather than a test binary timeout.
+// fn gets a TestingT that collects failures and, like [testing.T], stops fn
+// on Fatal, Fatalf or FailNow.
+//
+// If fn returns in time, its failures are reported to t. Otherwise the
+// failures collected so far are reported, followed by a fatal failure with a
+// dump of all goroutines. fn is left running, and the functions it registered
+// with Cleanup run when it returns. A panic in fn is propagated to the caller,
+// and a call to runtime.Goexit other than through FailNow is a failure.
+func WithTimeout(t TestingT, d time.Duration, fn func(t TestingT), opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	rt := &retryT{RecordingT: NewRecordingT(t)}
+	var panicked bool
+	var value any
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// rt.run calls fn on a goroutine of its own and records a
+		// runtime.Goexit there, so this goroutine only sees fn panic, as
+		// re-raised by rt.run. catchPanic carries that over to the caller.
+		panicked, value = catchPanic(func() { rt.run(fn) })
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-done:
+		if panicked {
+			panic(value)
+		}
+		for _, f := range rt.Failures() {
+			if f.Severity == SeverityFatal {
+				t.Fatalf("%s", f.Message)
+				return
+			}
+			t.Errorf("%s", f.Message)
+		}
+	case <-timer.C:
+		for _, f := range rt.Failures() {
+			t.Errorf("%s", f.Message)
+		}
+		t.Fatalf("got: still running after %v; want: done;%s\n  %s",
+			d, cfg.msg(), strings.ReplaceAll(goroutineDump(), "\n", "\n  "))
+	}
+}
+
+// goroutineDump returns the stacks of all goroutines.
+func goroutineDump() string {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return strings.TrimRight(string(buf[:n]), "\n")
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
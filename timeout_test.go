@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("in time", func(t *testing.T) {
+		rec := &RecordingT{}
+		WithTimeout(rec, time.Second, func(t TestingT) {
+			Equal(t, 1, 1)
+		})
+		if Failed(rec) {
+			t.Errorf("failed: %v", rec.Failures())
+		}
+	})
+
+	t.Run("failures in time", func(t *testing.T) {
+		rec := &RecordingT{}
+		after := false
+		WithTimeout(rec, time.Second, func(t TestingT) {
+			t.Errorf("first")
+			True(t, false, "stop")
+			after = true
+		})
+		if after {
+			t.Error("got: ran past fatal failure; want: stopped;")
+		}
+		var msgs []string
+		for _, f := range rec.Failures() {
+			msgs = append(msgs, f.Severity.String()+": "+f.Message)
+		}
+		wantMsgs := []string{"error: first", "fatal: got: false; want: true; stop"}
+		if !slices.Equal(msgs, wantMsgs) {
+			t.Errorf("got: %q; want: %q;", msgs, wantMsgs)
+		}
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		rec := &RecordingT{}
+		release := make(chan struct{})
+		defer close(release)
+		WithTimeout(rec, 10*time.Millisecond, func(t TestingT) {
+			t.Errorf("partial")
+			<-release
+		}, "handshake")
+
+		failures := rec.Failures()
+		if len(failures) != 2 {
+			t.Fatalf("got: %d failures; want: 2;", len(failures))
+		}
+		if failures[0].Severity != SeverityError || failures[0].Message != "partial" {
+			t.Errorf("got: %v; want: partial error;", failures[0])
+		}
+		msg := failures[1].Message
+		wantPrefix := "got: still running after 10ms; want: done; handshake\n  goroutine "
+		if failures[1].Severity != SeverityFatal || !strings.HasPrefix(msg, wantPrefix) {
+			t.Errorf("got: %q; want prefix: %q;", msg, wantPrefix)
+		}
+		if !strings.Contains(msg, "TestWithTimeout") {
+			t.Errorf("got: %q; want the stack of the blocked function;", msg)
+		}
+	})
+
+	t.Run("goexit", func(t *testing.T) {
+		rec := &RecordingT{}
+		WithTimeout(rec, time.Second, func(t TestingT) { runtime.Goexit() })
+		wantMsg := "got: runtime.Goexit; want: return;"
+		if f := rec.Failures(); len(f) != 1 || f[0].Severity != SeverityFatal || f[0].Message != wantMsg {
+			t.Errorf("got: %v; want: fatal %q;", f, wantMsg)
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("got: %v; want: boom;", r)
+			}
+		}()
+		WithTimeout(&RecordingT{}, time.Second, func(t TestingT) {
+			panic("boom")
+		})
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// errUnexpectedRequest is returned by a [ScriptedRoundTripper] for requests
+// that don't match the script.
+var errUnexpectedRequest = errors.New("assert: unexpected request")
+
+// ScriptedRoundTripper is an [http.RoundTripper] that answers requests from a
+// script of expected requests and canned responses, in order. Create one with
+// [ScriptedTransport].
+type ScriptedRoundTripper struct {
+	t   TestingT
+	cfg *config
+
+	mu        sync.Mutex
+	steps     []*ScriptedStep
+	next      int
+	exchanges []Exchange
+}
+
+// ScriptedStep is a single expected request in a [ScriptedRoundTripper]
+// script, created by [ScriptedRoundTripper.Expect].
+type ScriptedStep struct {
+	rt     *ScriptedRoundTripper
+	method string
+	path   string
+	status int
+	header http.Header
+	body   string
+}
+
+// Exchange is a request answered by a [ScriptedRoundTripper], along with the
+// response it was given.
+type Exchange struct {
+	Request *http.Request
+	// RequestBody holds the request body, which was consumed by the
+	// round trip.
+	RequestBody []byte
+	Response    *http.Response
+}
+
+// ScriptedTransport returns an empty [ScriptedRoundTripper]. Requests that
+// don't match the next expected step fail the test. When the test finishes,
+// it asserts that every expected request was made. t must provide Cleanup, as
+// [testing.T] does.
+func ScriptedTransport(t TestingT, opts ...any) *ScriptedRoundTripper {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	rt := &ScriptedRoundTripper{t: t, cfg: cfg}
+	ct, ok := t.(cleanupT)
+	if !ok {
+		t.Fatalf("scripted transport requires a TestingT with Cleanup, got %T", t)
+		return rt
+	}
+
+	ct.Cleanup(func() {
+		rt.mu.Lock()
+		defer rt.mu.Unlock()
+		if rt.next < len(rt.steps) {
+			t.Errorf("got: %d of %d expected requests; want: all; next: %s;%s",
+				rt.next, len(rt.steps), rt.steps[rt.next], cfg.msg())
+		}
+	})
+	return rt
+}
+
+// Expect adds a step expecting a request with the given method and path. If
+// path contains a query, it must match the request's query too.
+func (rt *ScriptedRoundTripper) Expect(method, path string) *ScriptedStep {
+	s := &ScriptedStep{rt: rt, method: method, path: path, status: http.StatusOK, header: http.Header{}}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.steps = append(rt.steps, s)
+	return s
+}
+
+// Respond sets the response for the step, and returns the round tripper for
+// chaining further steps.
+func (s *ScriptedStep) Respond(status int, body string) *ScriptedRoundTripper {
+	s.status = status
+	s.body = body
+	return s.rt
+}
+
+// WithHeader adds a header to the response for the step.
+func (s *ScriptedStep) WithHeader(key, value string) *ScriptedStep {
+	s.header.Add(key, value)
+	return s
+}
+
+func (s *ScriptedStep) String() string {
+	return s.method + " " + s.path
+}
+
+func (s *ScriptedStep) matches(req *http.Request) bool {
+	if req.Method != s.method {
+		return false
+	}
+	if strings.Contains(s.path, "?") {
+		return req.URL.RequestURI() == s.path
+	}
+	return req.URL.Path == s.path
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (rt *ScriptedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	got := req.Method + " " + req.URL.RequestURI()
+	if rt.next >= len(rt.steps) {
+		rt.t.Errorf("got: request %s; want: no more requests;%s", got, rt.cfg.msg())
+		return nil, fmt.Errorf("%w: %s", errUnexpectedRequest, got)
+	}
+	step := rt.steps[rt.next]
+	if !step.matches(req) {
+		rt.t.Errorf("got: request %s; want: %s;%s", got, step, rt.cfg.msg())
+		return nil, fmt.Errorf("%w: %s", errUnexpectedRequest, got)
+	}
+	rt.next++
+
+	resp := &http.Response{
+		Status:        strconv.Itoa(step.status) + " " + http.StatusText(step.status),
+		StatusCode:    step.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        step.header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(step.body)),
+		ContentLength: int64(len(step.body)),
+		Request:       req,
+	}
+	rt.exchanges = append(rt.exchanges, Exchange{Request: req, RequestBody: body, Response: resp})
+	return resp, nil
+}
+
+// Exchanges returns the requests answered so far, in order.
+func (rt *ScriptedRoundTripper) Exchanges() []Exchange {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return append([]Exchange(nil), rt.exchanges...)
+}
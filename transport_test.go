@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestScriptedTransport(t *testing.T) {
+	t.Run("scripted", func(t *testing.T) {
+		tb := &cleanupTB{}
+		rt := ScriptedTransport(tb).
+			Expect("GET", "/users/1").Respond(200, `{"id":1}`).
+			Expect("POST", "/users?notify=1").WithHeader("Location", "/users/2").Respond(201, "")
+		client := &http.Client{Transport: rt}
+
+		resp, err := client.Get("http://example.com/users/1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != 200 || string(body) != `{"id":1}` {
+			t.Errorf("got: %d %q; want: 200 %q;", resp.StatusCode, body, `{"id":1}`)
+		}
+
+		resp, err = client.Post("http://example.com/users?notify=1", "text/plain", strings.NewReader("bob"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.Header.Get("Location"); resp.StatusCode != 201 || got != "/users/2" {
+			t.Errorf("got: %d %q; want: 201 %q;", resp.StatusCode, got, "/users/2")
+		}
+
+		tb.runCleanups()
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+
+		exchanges := rt.Exchanges()
+		if len(exchanges) != 2 {
+			t.Fatalf("got: %d exchanges; want: 2;", len(exchanges))
+		}
+		if got := string(exchanges[1].RequestBody); got != "bob" {
+			t.Errorf("got: %q; want: %q;", got, "bob")
+		}
+	})
+
+	t.Run("unexpected request", func(t *testing.T) {
+		tb := &cleanupTB{}
+		rt := ScriptedTransport(tb).Expect("GET", "/users/1").Respond(200, "")
+		req, _ := http.NewRequest("DELETE", "http://example.com/users/1?force=1", nil)
+		_, err := rt.RoundTrip(req)
+		if !errors.Is(err, errUnexpectedRequest) {
+			t.Errorf("got: %v; want: %v;", err, errUnexpectedRequest)
+		}
+		wantMsg := "got: request DELETE /users/1?force=1; want: GET /users/1;"
+		if tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: fatal=%v %q; want: fatal=false %q;", tb.fatal, tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("query mismatch", func(t *testing.T) {
+		tb := &cleanupTB{}
+		rt := ScriptedTransport(tb).Expect("GET", "/users?page=2").Respond(200, "")
+		req, _ := http.NewRequest("GET", "http://example.com/users?page=3", nil)
+		if _, err := rt.RoundTrip(req); err == nil {
+			t.Error("should have failed")
+		}
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		tb := &cleanupTB{}
+		rt := ScriptedTransport(tb, "client")
+		req, _ := http.NewRequest("GET", "http://example.com/", nil)
+		if _, err := rt.RoundTrip(req); err == nil {
+			t.Error("should have failed")
+		}
+		wantMsg := "got: request GET /; want: no more requests; client"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("unconsumed", func(t *testing.T) {
+		tb := &cleanupTB{}
+		ScriptedTransport(tb).
+			Expect("GET", "/a").Respond(200, "").
+			Expect("GET", "/b").Respond(200, "")
+		tb.runCleanups()
+		wantMsg := "got: 0 of 2 expected requests; want: all; next: GET /a;"
+		if tb.fatal || tb.msg != wantMsg {
+			t.Errorf("got: fatal=%v %q; want: fatal=false %q;", tb.fatal, tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("without cleanup", func(t *testing.T) {
+		rec := &RecordingT{}
+		ScriptedTransport(rec)
+		wantMsg := "scripted transport requires a TestingT with Cleanup, got *assert.RecordingT"
+		if f := rec.Failures(); len(f) != 1 || f[0].Message != wantMsg {
+			t.Errorf("got: %v; want: %q;", f, wantMsg)
+		}
+	})
+}
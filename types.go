@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// IsType asserts that got is of type T, or implements T if T is an interface
+// type, and returns got as a T.
+func IsType[T any](t TestingT, got any, opts ...any) T {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	v, ok := got.(T)
+	if !ok {
+		t.Fatalf("got: %T; want: %v;%s", got, reflect.TypeFor[T](), cfg.msg())
+	}
+	return v
+}
+
+// NotType asserts that got is not of type T, and does not implement T if T is
+// an interface type.
+func NotType[T any](t TestingT, got any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	if _, ok := got.(T); ok {
+		t.Fatalf("got: %T; expected type other than %v;%s", got, reflect.TypeFor[T](), cfg.msg())
+	}
+}
+
+// Implements asserts that the dynamic type of got implements the interface
+// type I. On failure the missing methods are listed.
+func Implements[I any](t TestingT, got any, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	iface := reflect.TypeFor[I]()
+	if iface.Kind() != reflect.Interface {
+		t.Fatalf("%v is not an interface type;%s", iface, cfg.msg())
+		return
+	}
+
+	if got == nil {
+		t.Fatalf("got: <nil>; want implementation of %v;%s", iface, cfg.msg())
+		return
+	}
+
+	typ := reflect.TypeOf(got)
+	if typ.Implements(iface) {
+		return
+	}
+
+	hint := ""
+	if typ.Kind() != reflect.Pointer && reflect.PointerTo(typ).Implements(iface) {
+		hint = " (implemented by pointer)"
+	}
+	t.Fatalf("got: %v; does not implement %v%s; missing methods: %s;%s",
+		typ, iface, hint, strings.Join(missingMethods(typ, iface), ", "), cfg.msg())
+}
+
+// missingMethods lists the methods of iface that typ lacks, or has with a
+// different signature.
+func missingMethods(typ, iface reflect.Type) []string {
+	var missing []string
+	for i := range iface.NumMethod() {
+		want := iface.Method(i)
+		have, ok := typ.MethodByName(want.Name)
+		switch {
+		case !ok:
+			missing = append(missing, methodSignature(want.Name, want.Type))
+		case !methodTypeMatches(have.Type, want.Type):
+			missing = append(missing, fmt.Sprintf("%s (have %s)",
+				methodSignature(want.Name, want.Type), methodSignature(have.Name, stripReceiver(have.Type))))
+		}
+	}
+	return missing
+}
+
+// methodSignature renders a method such as "Close() error".
+func methodSignature(name string, typ reflect.Type) string {
+	return name + strings.TrimPrefix(typ.String(), "func")
+}
+
+// stripReceiver drops the receiver argument from the type of a method
+// obtained from a concrete type.
+func stripReceiver(typ reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, typ.NumIn())
+	for i := 1; i < typ.NumIn(); i++ {
+		in = append(in, typ.In(i))
+	}
+	out := make([]reflect.Type, 0, typ.NumOut())
+	for i := range typ.NumOut() {
+		out = append(out, typ.Out(i))
+	}
+	return reflect.FuncOf(in, out, typ.IsVariadic())
+}
+
+func methodTypeMatches(have, want reflect.Type) bool {
+	return stripReceiver(have) == want
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestIsType(t *testing.T) {
+	t.Run("concrete type", func(t *testing.T) {
+		tb := &mockTB{}
+		got := IsType[intType](tb, intType{42})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if got.val != 42 {
+			t.Errorf("got: %#v; want: %#v;", got, intType{42})
+		}
+
+		tb2 := &mockTB{}
+		NotType[intType](tb2, intType{42})
+		if !tb2.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "got: assert.intType; expected type other than assert.intType;"
+		if tb2.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb2.msg, wantMsg)
+		}
+	})
+
+	t.Run("interface type", func(t *testing.T) {
+		tb := &mockTB{}
+		got := IsType[error](tb, errType("oops"))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+		if got.Error() != "oops" {
+			t.Errorf("got: %q; want: %q;", got.Error(), "oops")
+		}
+
+		tb2 := &mockTB{}
+		NotType[fmt.Stringer](tb2, errType("oops"))
+		if tb2.failed {
+			t.Errorf("failed: %s", tb2.msg)
+		}
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		tb := &mockTB{}
+		IsType[errType](tb, errors.New("oops"))
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+		wantMsg := "got: *errors.errorString; want: assert.errType;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		tb := &mockTB{}
+		IsType[error](tb, nil)
+		wantMsg := "got: <nil>; want: error;"
+		if tb.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", tb.msg, wantMsg)
+		}
+
+		tb2 := &mockTB{}
+		NotType[error](tb2, nil)
+		if tb2.failed {
+			t.Errorf("failed: %s", tb2.msg)
+		}
+	})
+}
+
+// closer implements io.Closer with a pointer receiver.
+type closer struct{}
+
+func (c *closer) Close() error {
+	return nil
+}
+
+// badCloser has a Close method with the wrong signature.
+type badCloser struct{}
+
+func (badCloser) Close(force bool) {}
+
+func TestImplements(t *testing.T) {
+	testCases := map[string]struct {
+		check func(tb TestingT)
+		msg   string
+	}{
+		"implements": {
+			check: func(tb TestingT) { Implements[error](tb, errType("oops")) },
+		},
+		"pointer implements": {
+			check: func(tb TestingT) { Implements[io.Closer](tb, &closer{}) },
+		},
+		"missing method": {
+			check: func(tb TestingT) { Implements[io.Closer](tb, errType("oops")) },
+			msg:   "got: assert.errType; does not implement io.Closer; missing methods: Close() error;",
+		},
+		"implemented by pointer": {
+			check: func(tb TestingT) { Implements[io.Closer](tb, closer{}) },
+			msg:   "got: assert.closer; does not implement io.Closer (implemented by pointer); missing methods: Close() error;",
+		},
+		"wrong signature": {
+			check: func(tb TestingT) { Implements[io.Closer](tb, badCloser{}) },
+			msg:   "got: assert.badCloser; does not implement io.Closer; missing methods: Close() error (have Close(bool));",
+		},
+		"nil": {
+			check: func(tb TestingT) { Implements[io.Closer](tb, nil) },
+			msg:   "got: <nil>; want implementation of io.Closer;",
+		},
+		"not an interface": {
+			check: func(tb TestingT) { Implements[intType](tb, intType{42}, "closer") },
+			msg:   "assert.intType is not an interface type; closer",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			tc.check(tb)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}
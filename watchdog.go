@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Watchdog catches assertions made against a test after it has completed,
+// typically from a goroutine the test leaked. [testing.T] panics in that
+// case, aborting the whole test binary with little hint of where the
+// assertion came from. Tests watched by a Watchdog report such assertions as
+// failures of the parent test instead, naming the offending test:
+//
+//	func TestWorkers(t *testing.T) {
+//		w := assert.NewWatchdog(t)
+//		t.Run("start", func(t *testing.T) {
+//			wt := w.Watch(t)
+//			go func() { assert.Nil(wt, work()) }()
+//		})
+//	}
+//
+// Assertions made after the parent test has completed too are written to
+// standard error.
+type Watchdog struct {
+	parent TestingT
+
+	mu   sync.Mutex
+	done bool
+	late []string
+}
+
+// NewWatchdog returns a Watchdog reporting to parent. Late assertions are
+// reported when parent completes, so parent must have a Cleanup method.
+func NewWatchdog(parent TestingT) *Watchdog {
+	if ht, ok := parent.(helperT); ok {
+		ht.Helper()
+	}
+
+	w := &Watchdog{parent: parent}
+	ct, ok := parent.(cleanupT)
+	if !ok {
+		parent.Fatalf("watchdog requires a TestingT with Cleanup, got %T", parent)
+		return w
+	}
+	ct.Cleanup(w.report)
+	return w
+}
+
+// Watch returns a TestingT that reports to t while its test is running, and
+// to the watchdog afterwards. t must have a Cleanup method, which is used to
+// tell when its test completes.
+func (w *Watchdog) Watch(t TestingT) TestingT {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	wt := &watchedT{t: t, w: w, name: testName(t)}
+	ct, ok := t.(cleanupT)
+	if !ok {
+		t.Fatalf("watchdog requires a TestingT with Cleanup, got %T", t)
+		return wt
+	}
+	ct.Cleanup(func() {
+		wt.mu.Lock()
+		defer wt.mu.Unlock()
+		wt.done = true
+	})
+	return wt
+}
+
+// lateFailure records a failure raised through a watched test after it
+// completed.
+func (w *Watchdog) lateFailure(name, msg string) {
+	if name == "" {
+		name = "unnamed test"
+	}
+	msg = fmt.Sprintf("assertion in %s after it completed: %s", name, msg)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		fmt.Fprintln(os.Stderr, msg)
+		return
+	}
+	w.late = append(w.late, msg)
+}
+
+func (w *Watchdog) report() {
+	w.mu.Lock()
+	late := w.late
+	w.late, w.done = nil, true
+	w.mu.Unlock()
+
+	for _, msg := range late {
+		w.parent.Errorf("%s", msg)
+	}
+}
+
+// watchedT is the TestingT returned by [Watchdog.Watch].
+type watchedT struct {
+	t    TestingT
+	w    *Watchdog
+	name string
+
+	mu   sync.Mutex
+	done bool
+}
+
+func (wt *watchedT) Helper() {
+	if ht, ok := wt.t.(helperT); ok {
+		ht.Helper()
+	}
+}
+
+func (wt *watchedT) Name() string {
+	return wt.name
+}
+
+func (wt *watchedT) Error(args ...any) {
+	wt.mu.Lock()
+	defer wt.mu.Unlock()
+	if wt.done {
+		wt.w.lateFailure(wt.name, fmt.Sprint(args...))
+		return
+	}
+	wt.t.Error(args...)
+}
+
+func (wt *watchedT) Errorf(format string, args ...any) {
+	wt.mu.Lock()
+	defer wt.mu.Unlock()
+	if wt.done {
+		wt.w.lateFailure(wt.name, fmt.Sprintf(format, args...))
+		return
+	}
+	wt.t.Errorf(format, args...)
+}
+
+func (wt *watchedT) Fatal(args ...any) {
+	wt.mu.Lock()
+	defer wt.mu.Unlock()
+	if wt.done {
+		wt.w.lateFailure(wt.name, fmt.Sprint(args...))
+		return
+	}
+	wt.t.Fatal(args...)
+}
+
+func (wt *watchedT) Fatalf(format string, args ...any) {
+	wt.mu.Lock()
+	defer wt.mu.Unlock()
+	if wt.done {
+		wt.w.lateFailure(wt.name, fmt.Sprintf(format, args...))
+		return
+	}
+	wt.t.Fatalf(format, args...)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"testing"
+)
+
+// namedTB is a cleanupTB with a test name.
+type namedTB struct {
+	cleanupTB
+	name string
+}
+
+func (n *namedTB) Name() string {
+	return n.name
+}
+
+func TestWatchdog(t *testing.T) {
+	t.Run("late assertion", func(t *testing.T) {
+		parent := &cleanupTB{}
+		w := NewWatchdog(parent)
+
+		var wt TestingT
+		t.Run("child", func(t *testing.T) {
+			wt = w.Watch(t)
+		})
+		// The child test has completed, so this would panic if made
+		// against its testing.T directly.
+		Equal(wt, 1, 2, "leaked")
+
+		if parent.failed {
+			t.Fatalf("reported before parent completed: %s", parent.msg)
+		}
+		parent.runCleanups()
+		wantMsg := "assertion in TestWatchdog/late_assertion/child after it completed: got: 1; want: 2; leaked"
+		if parent.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", parent.msg, wantMsg)
+		}
+		if parent.fatal {
+			t.Error("should not be fatal")
+		}
+	})
+
+	t.Run("running test", func(t *testing.T) {
+		parent := &cleanupTB{}
+		w := NewWatchdog(parent)
+		child := &namedTB{name: "TestChild"}
+		wt := w.Watch(child)
+
+		Equal(wt, 1, 2)
+		if !child.fatal || child.msg != "got: 1; want: 2;" {
+			t.Errorf("got: %q; want: fatal %q;", child.msg, "got: 1; want: 2;")
+		}
+		child.runCleanups()
+		True(wt, false, "flag")
+		parent.runCleanups()
+		wantMsg := `assertion in TestChild after it completed: got: false; want: true; flag`
+		if parent.msg != wantMsg {
+			t.Errorf("got: %q; want: %q;", parent.msg, wantMsg)
+		}
+	})
+
+	t.Run("no cleanup", func(t *testing.T) {
+		rec := &RecordingT{}
+		NewWatchdog(rec)
+		f := rec.Failures()
+		if len(f) != 1 || f[0].Message != "watchdog requires a TestingT with Cleanup, got *assert.RecordingT" {
+			t.Errorf("unexpected failures: %v", f)
+		}
+	})
+}
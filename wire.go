@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// WireEqual asserts that two raw HTTP/1 messages are semantically equal.
+// Both must be requests, or both responses. Requests are compared by method,
+// target, protocol, headers and body; responses by protocol, status code,
+// headers and body. Header names are canonicalized, and chunked bodies are
+// decoded before comparison, so messages may differ in bytes but still match.
+// The framing headers Content-Length and Transfer-Encoding are not compared,
+// as they describe how the body was sent rather than what it holds.
+func WireEqual(t TestingT, gotRaw, wantRaw []byte, opts ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+	cfg := newConfig(t, opts...)
+
+	got, err := parseWire(gotRaw)
+	if err != nil {
+		t.Fatalf("got: invalid HTTP message: %v;%s", err, cfg.msg())
+		return
+	}
+	want, err := parseWire(wantRaw)
+	if err != nil {
+		t.Fatalf("want: invalid HTTP message: %v;%s", err, cfg.msg())
+		return
+	}
+
+	if diffs := got.diff(want); len(diffs) > 0 {
+		t.Fatalf("got and want differ;%s\n  %s", cfg.msg(), strings.Join(diffs, "\n  "))
+	}
+}
+
+// wireMessage holds the parts of an HTTP/1 message that WireEqual compares.
+type wireMessage struct {
+	fields [][2]string // ordered name/value pairs of the start line
+	header http.Header
+	body   []byte
+}
+
+func parseWire(raw []byte) (*wireMessage, error) {
+	r := bufio.NewReader(bytes.NewReader(raw))
+
+	if bytes.HasPrefix(raw, []byte("HTTP/")) {
+		resp, err := http.ReadResponse(r, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &wireMessage{
+			fields: [][2]string{
+				{"kind", "response"},
+				{"proto", resp.Proto},
+				{"status", fmt.Sprint(resp.StatusCode)},
+			},
+			header: unframed(resp.Header),
+			body:   body,
+		}, nil
+	}
+
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer req.Body.Close()
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	// ReadRequest moves the Host header into req.Host.
+	header := req.Header.Clone()
+	if req.Host != "" {
+		header.Set("Host", req.Host)
+	}
+	return &wireMessage{
+		fields: [][2]string{
+			{"kind", "request"},
+			{"method", req.Method},
+			{"target", req.RequestURI},
+			{"proto", req.Proto},
+		},
+		header: unframed(header),
+		body:   body,
+	}, nil
+}
+
+// unframed returns a copy of h without the headers that describe how a body
+// was framed on the wire, since bodies are compared once decoded.
+func unframed(h http.Header) http.Header {
+	h = h.Clone()
+	h.Del("Content-Length")
+	h.Del("Transfer-Encoding")
+	return h
+}
+
+// diff describes each difference between m and want, one per line.
+func (m *wireMessage) diff(want *wireMessage) []string {
+	if m.fields[0] != want.fields[0] {
+		return []string{fmt.Sprintf("kind: got %s; want %s", m.fields[0][1], want.fields[0][1])}
+	}
+
+	var diffs []string
+	for i, f := range m.fields[1:] {
+		if w := want.fields[i+1]; f != w {
+			diffs = append(diffs, fmt.Sprintf("%s: got %q; want %q", f[0], f[1], w[1]))
+		}
+	}
+
+	names := make([]string, 0, len(m.header)+len(want.header))
+	for name := range m.header {
+		names = append(names, name)
+	}
+	for name := range want.header {
+		if _, ok := m.header[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		g, w := m.header[name], want.header[name]
+		switch {
+		case g == nil:
+			diffs = append(diffs, fmt.Sprintf("header %s: missing; want %q", name, w))
+		case w == nil:
+			diffs = append(diffs, fmt.Sprintf("header %s: got %q; want none", name, g))
+		case !slices.Equal(g, w):
+			diffs = append(diffs, fmt.Sprintf("header %s: got %q; want %q", name, g, w))
+		}
+	}
+
+	if !bytes.Equal(m.body, want.body) {
+		diffs = append(diffs, fmt.Sprintf("body: got %q; want %q", m.body, want.body))
+	}
+	return diffs
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Eli Janssen
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWireEqual(t *testing.T) {
+	crlf := func(s string) []byte {
+		return []byte(strings.ReplaceAll(s, "\n", "\r\n"))
+	}
+
+	request := crlf("POST /items?id=1 HTTP/1.1\nHost: example.com\nContent-Type: application/json\nContent-Length: 2\n\n{}")
+
+	testCases := map[string]struct {
+		got, want []byte
+		msg       string
+	}{
+		"identical": {got: request, want: request},
+		"header case and order": {
+			got:  crlf("POST /items?id=1 HTTP/1.1\ncontent-length: 2\ncontent-type: application/json\nhost: example.com\n\n{}"),
+			want: request,
+		},
+		"chunked body": {
+			got:  crlf("POST /items?id=1 HTTP/1.1\nHost: example.com\nContent-Type: application/json\nTransfer-Encoding: chunked\n\n1\n{\n1\n}\n0\n\n"),
+			want: crlf("POST /items?id=1 HTTP/1.1\nHost: example.com\nContent-Type: application/json\nTransfer-Encoding: chunked\n\n2\n{}\n0\n\n"),
+		},
+		"chunked and sized body": {
+			got:  crlf("HTTP/1.1 200 OK\nTransfer-Encoding: chunked\n\n2\nhe\n3\nllo\n0\n\n"),
+			want: crlf("HTTP/1.1 200 OK\nContent-Length: 5\n\nhello"),
+		},
+		"sized and chunked body differ": {
+			got:  crlf("POST /items?id=1 HTTP/1.1\nHost: example.com\nContent-Type: application/json\nTransfer-Encoding: chunked\n\n3\n{ }\n0\n\n"),
+			want: request,
+			msg:  "got and want differ;\n" + `  body: got "{ }"; want "{}"`,
+		},
+		"response": {
+			got:  crlf("HTTP/1.1 200 OK\ncontent-length: 5\n\nhello"),
+			want: crlf("HTTP/1.1 200 Fine\nContent-Length: 5\n\nhello"),
+		},
+		"request fields": {
+			got:  crlf("GET /items?id=2 HTTP/1.0\nHost: example.com\nContent-Type: application/json\nContent-Length: 2\n\n{}"),
+			want: request,
+			msg: "got and want differ;\n" +
+				`  method: got "GET"; want "POST"` + "\n" +
+				`  target: got "/items?id=2"; want "/items?id=1"` + "\n" +
+				`  proto: got "HTTP/1.0"; want "HTTP/1.1"`,
+		},
+		"headers and body": {
+			got:  crlf("POST /items?id=1 HTTP/1.1\nHost: example.org\nContent-Length: 3\nX-Extra: 1\n\n{ }"),
+			want: request,
+			msg: "got and want differ;\n" +
+				`  header Content-Type: missing; want ["application/json"]` + "\n" +
+				`  header Host: got ["example.org"]; want ["example.com"]` + "\n" +
+				`  header X-Extra: got ["1"]; want none` + "\n" +
+				`  body: got "{ }"; want "{}"`,
+		},
+		"status": {
+			got:  crlf("HTTP/1.1 404 Not Found\nContent-Length: 0\n\n"),
+			want: crlf("HTTP/1.1 200 OK\nContent-Length: 0\n\n"),
+			msg:  "got and want differ;\n" + `  status: got "404"; want "200"`,
+		},
+		"kind": {
+			got:  crlf("HTTP/1.1 200 OK\nContent-Length: 0\n\n"),
+			want: request,
+			msg:  "got and want differ;\n  kind: got response; want request",
+		},
+		"invalid got": {
+			got:  []byte("nonsense"),
+			want: request,
+			msg:  `got: invalid HTTP message: malformed HTTP request "nonsense";`,
+		},
+		"invalid want": {
+			got:  request,
+			want: crlf("HTTP/1.1 abc OK\n\n"),
+			msg:  `want: invalid HTTP message: malformed HTTP status code "abc";`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tb := &mockTB{}
+			WireEqual(tb, tc.got, tc.want)
+			if tc.msg == "" {
+				if tb.failed {
+					t.Errorf("failed: %s", tb.msg)
+				}
+				return
+			}
+			if !tb.fatal {
+				t.Error("should be fatal")
+			}
+			if tb.msg != tc.msg {
+				t.Errorf("got: %q; want: %q;", tb.msg, tc.msg)
+			}
+		})
+	}
+}